@@ -19,35 +19,64 @@ import (
 // It combines the functionality of the rest, gateway/sharding, httpserver and cache into one easy to use package.
 // Create a new client with disgo.New.
 type Client struct {
-	Token                 string
-	ApplicationID         snowflake.ID
-	Logger                *slog.Logger
-	Rest                  rest.Rest
-	EventManager          EventManager
-	ShardManager          sharding.ShardManager
-	Gateway               gateway.Gateway
-	HTTPServer            httpserver.Server
-	VoiceManager          voice.Manager
-	Caches                cache.Caches
-	MemberChunkingManager MemberChunkingManager
-}
-
+	Token                   string
+	ApplicationID           snowflake.ID
+	Logger                  *slog.Logger
+	Rest                    rest.Rest
+	EventManager            EventManager
+	ShardManager            sharding.ShardManager
+	Gateway                 gateway.Gateway
+	HTTPServer              httpserver.Server
+	VoiceManager            voice.Manager
+	Caches                  cache.Caches
+	MemberChunkingManager   MemberChunkingManager
+	MemberChunkingScheduler MemberChunkingScheduler
+	InteractionHydrator     InteractionHydrator
+	Modules                 ModuleManager
+}
+
+// Close gracefully shuts down the Client.
+// It stops handling new Gateway & HTTP events and waits for in-flight EventListener(s) and functions started with Client.Go
+// until the context.Context is done or the drain timeout of the EventManager passed (see WithDrainTimeout).
+// Afterward, it stops all Module(s) and closes the voice connections, the Gateway or ShardManager, the HTTPServer and lastly the Rest client.
 func (c *Client) Close(ctx context.Context) {
+	if c.EventManager != nil {
+		if err := c.EventManager.Drain(ctx); err != nil {
+			c.Logger.Warn("closing client before all in-flight events were handled", slog.Any("err", err))
+		}
+	}
+	if c.MemberChunkingScheduler != nil {
+		c.MemberChunkingScheduler.Close()
+	}
+	if c.Modules != nil {
+		if err := c.Modules.Stop(ctx); err != nil {
+			c.Logger.Error("failed to stop modules", slog.Any("err", err))
+		}
+	}
 	if c.VoiceManager != nil {
 		c.VoiceManager.Close(ctx)
 	}
 	if c.Gateway != nil {
 		c.Gateway.Close(ctx)
 	}
-	if c.Rest != nil {
-		c.Rest.Close(ctx)
-	}
 	if c.ShardManager != nil {
 		c.ShardManager.Close(ctx)
 	}
 	if c.HTTPServer != nil {
 		c.HTTPServer.Close(ctx)
 	}
+	if c.Rest != nil {
+		c.Rest.Close(ctx)
+	}
+}
+
+// Go runs f in a new goroutine which Client.Close waits for before closing the transports.
+func (c *Client) Go(f func()) {
+	if c == nil || c.EventManager == nil {
+		go f()
+		return
+	}
+	c.EventManager.Go(f)
 }
 
 func (c *Client) ID() snowflake.ID {
@@ -0,0 +1,233 @@
+package bot
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// CollectorEndReason is the reason a Collector ended.
+type CollectorEndReason int
+
+const (
+	// CollectorEndReasonNone means the Collector did not end yet.
+	CollectorEndReasonNone CollectorEndReason = iota
+	// CollectorEndReasonLimit means the Collector collected the configured max events.
+	CollectorEndReasonLimit
+	// CollectorEndReasonTimeout means the configured timeout of the Collector passed.
+	CollectorEndReasonTimeout
+	// CollectorEndReasonIdle means the Collector did not collect an event within the configured idle timeout.
+	CollectorEndReasonIdle
+	// CollectorEndReasonCancelled means the context.Context of the Collector was cancelled.
+	CollectorEndReasonCancelled
+	// CollectorEndReasonStopped means Collector.Stop was called.
+	CollectorEndReasonStopped
+)
+
+func (r CollectorEndReason) String() string {
+	switch r {
+	case CollectorEndReasonNone:
+		return "none"
+	case CollectorEndReasonLimit:
+		return "limit"
+	case CollectorEndReasonTimeout:
+		return "timeout"
+	case CollectorEndReasonIdle:
+		return "idle"
+	case CollectorEndReasonCancelled:
+		return "cancelled"
+	case CollectorEndReasonStopped:
+		return "stopped"
+	default:
+		return "unknown"
+	}
+}
+
+// CollectorDelivery decides how a Collector sends events to its Collector.Events channel.
+type CollectorDelivery int
+
+const (
+	// CollectorDeliveryNonBlocking skips sending an event to the channel if its buffer is full. The event is still collected.
+	CollectorDeliveryNonBlocking CollectorDelivery = iota
+	// CollectorDeliveryBuffered blocks the dispatching goroutine until the buffer of the channel has space or the Collector ends.
+	CollectorDeliveryBuffered
+)
+
+// CollectorResult is the result of a Collector.
+type CollectorResult[E Event] struct {
+	Items  []E
+	Reason CollectorEndReason
+}
+
+// NewCollector returns a new started Collector which collects all events of type E passing the filterFunc until it ends.
+// A nil filterFunc collects all events of type E. The Collector ends with CollectorEndReasonCancelled when the context.Context is cancelled.
+func NewCollector[E Event](client *Client, ctx context.Context, filterFunc func(e E) bool, opts ...CollectorConfigOpt) *Collector[E] {
+	cfg := defaultCollectorConfig()
+	cfg.apply(opts)
+
+	c := &Collector[E]{
+		client:     client,
+		config:     cfg,
+		filterFunc: filterFunc,
+		ch:         make(chan E, max(cfg.BufferSize, 0)),
+		done:       make(chan struct{}),
+	}
+	if cfg.DedupeKey != nil {
+		c.keys = map[any]struct{}{}
+	}
+	c.listener = NewListenerFunc(c.onEvent)
+	client.EventManager.AddEventListeners(c.listener)
+
+	if cfg.Timeout > 0 {
+		c.timer = time.AfterFunc(cfg.Timeout, func() { c.end(CollectorEndReasonTimeout) })
+	}
+	if cfg.IdleTimeout > 0 {
+		c.idleTimer = time.AfterFunc(cfg.IdleTimeout, func() { c.end(CollectorEndReasonIdle) })
+	}
+	if ctx.Done() != nil {
+		go func() {
+			select {
+			case <-ctx.Done():
+				c.end(CollectorEndReasonCancelled)
+			case <-c.done:
+			}
+		}()
+	}
+	return c
+}
+
+// Collector collects events of type E until it reaches its max count, one of its timeouts passes, its context.Context is cancelled or it is stopped.
+type Collector[E Event] struct {
+	client     *Client
+	config     collectorConfig
+	filterFunc func(e E) bool
+	listener   EventListener
+	timer      *time.Timer
+	idleTimer  *time.Timer
+
+	ch      chan E
+	done    chan struct{}
+	endOnce sync.Once
+	// sendMu is held for reading while sending to ch, so it is only closed once no send is in progress
+	sendMu sync.RWMutex
+
+	mu     sync.Mutex
+	items  []E
+	keys   map[any]struct{}
+	reason CollectorEndReason
+}
+
+// Events returns a channel which receives the collected events according to the CollectorDelivery. It is closed when the Collector ends.
+func (c *Collector[E]) Events() <-chan E {
+	return c.ch
+}
+
+// Done returns a channel which is closed when the Collector ends.
+func (c *Collector[E]) Done() <-chan struct{} {
+	return c.done
+}
+
+// Stop ends the Collector with CollectorEndReasonStopped.
+func (c *Collector[E]) Stop() {
+	c.end(CollectorEndReasonStopped)
+}
+
+// Wait blocks until the Collector ends or the context.Context is cancelled and returns the current CollectorResult.
+func (c *Collector[E]) Wait(ctx context.Context) CollectorResult[E] {
+	select {
+	case <-c.done:
+	case <-ctx.Done():
+	}
+	return c.Result()
+}
+
+// Result returns the events collected so far and the CollectorEndReason or CollectorEndReasonNone if the Collector did not end yet.
+func (c *Collector[E]) Result() CollectorResult[E] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	items := make([]E, len(c.items))
+	copy(items, c.items)
+	return CollectorResult[E]{
+		Items:  items,
+		Reason: c.reason,
+	}
+}
+
+func (c *Collector[E]) onEvent(e E) {
+	if c.filterFunc != nil && !c.filterFunc(e) {
+		return
+	}
+
+	c.mu.Lock()
+	// events can be dispatched concurrently, so the limit is checked before collecting the event
+	if c.reason != CollectorEndReasonNone || (c.config.Max > 0 && len(c.items) >= c.config.Max) {
+		c.mu.Unlock()
+		return
+	}
+	if c.keys != nil {
+		key := c.config.DedupeKey(e)
+		if _, ok := c.keys[key]; ok {
+			c.mu.Unlock()
+			return
+		}
+		c.keys[key] = struct{}{}
+	}
+	c.items = append(c.items, e)
+	if c.idleTimer != nil {
+		c.idleTimer.Reset(c.config.IdleTimeout)
+	}
+	limitReached := c.config.Max > 0 && len(c.items) >= c.config.Max
+	if limitReached {
+		c.reason = CollectorEndReasonLimit
+	}
+	c.mu.Unlock()
+
+	c.sendMu.RLock()
+	select {
+	case <-c.done:
+		// the Collector ended concurrently and the channel might already be closed
+	default:
+		if c.config.Delivery == CollectorDeliveryBuffered {
+			select {
+			case c.ch <- e:
+			case <-c.done:
+			}
+		} else {
+			select {
+			case c.ch <- e:
+			default:
+			}
+		}
+	}
+	c.sendMu.RUnlock()
+
+	if limitReached {
+		c.end(CollectorEndReasonLimit)
+	}
+}
+
+func (c *Collector[E]) end(reason CollectorEndReason) {
+	c.endOnce.Do(func() {
+		close(c.done)
+		if c.timer != nil {
+			c.timer.Stop()
+		}
+		if c.idleTimer != nil {
+			c.idleTimer.Stop()
+		}
+
+		c.mu.Lock()
+		// the reason is already set if the limit was reached
+		if c.reason == CollectorEndReasonNone {
+			c.reason = reason
+		}
+		c.mu.Unlock()
+
+		// closing done unblocks all buffered sends, so this only waits for sends which are already in progress
+		c.sendMu.Lock()
+		close(c.ch)
+		c.sendMu.Unlock()
+
+		c.client.EventManager.RemoveEventListeners(c.listener)
+	})
+}
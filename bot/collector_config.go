@@ -0,0 +1,73 @@
+package bot
+
+import (
+	"time"
+)
+
+func defaultCollectorConfig() collectorConfig {
+	return collectorConfig{
+		BufferSize: 100,
+		Delivery:   CollectorDeliveryNonBlocking,
+	}
+}
+
+type collectorConfig struct {
+	Max         int
+	Timeout     time.Duration
+	IdleTimeout time.Duration
+	DedupeKey   func(event Event) any
+	BufferSize  int
+	Delivery    CollectorDelivery
+}
+
+// CollectorConfigOpt is a functional option for configuring a Collector.
+type CollectorConfigOpt func(config *collectorConfig)
+
+func (c *collectorConfig) apply(opts []CollectorConfigOpt) {
+	for _, opt := range opts {
+		opt(c)
+	}
+}
+
+// WithCollectorMax ends the Collector with CollectorEndReasonLimit after collecting max events.
+func WithCollectorMax(max int) CollectorConfigOpt {
+	return func(config *collectorConfig) {
+		config.Max = max
+	}
+}
+
+// WithCollectorTimeout ends the Collector with CollectorEndReasonTimeout after the given time.Duration.
+func WithCollectorTimeout(timeout time.Duration) CollectorConfigOpt {
+	return func(config *collectorConfig) {
+		config.Timeout = timeout
+	}
+}
+
+// WithCollectorIdleTimeout ends the Collector with CollectorEndReasonIdle if no event was collected within the given time.Duration.
+func WithCollectorIdleTimeout(timeout time.Duration) CollectorConfigOpt {
+	return func(config *collectorConfig) {
+		config.IdleTimeout = timeout
+	}
+}
+
+// WithCollectorDedupeKey only collects the first event of each key returned by keyFunc.
+// The returned key must be comparable.
+func WithCollectorDedupeKey(keyFunc func(event Event) any) CollectorConfigOpt {
+	return func(config *collectorConfig) {
+		config.DedupeKey = keyFunc
+	}
+}
+
+// WithCollectorBufferSize sets the buffer size of the Collector.Events channel. Defaults to 100.
+func WithCollectorBufferSize(size int) CollectorConfigOpt {
+	return func(config *collectorConfig) {
+		config.BufferSize = size
+	}
+}
+
+// WithCollectorDelivery sets the CollectorDelivery of the Collector.Events channel. Defaults to CollectorDeliveryNonBlocking.
+func WithCollectorDelivery(delivery CollectorDelivery) CollectorConfigOpt {
+	return func(config *collectorConfig) {
+		config.Delivery = delivery
+	}
+}
@@ -0,0 +1,115 @@
+package bot
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestClient() *Client {
+	client := &Client{}
+	client.EventManager = NewEventManager(client)
+	return client
+}
+
+func TestCollector_Limit(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient()
+	c := NewCollector(client, context.Background(), func(e *testEvent) bool {
+		return e.n%2 == 0
+	}, WithCollectorMax(2), WithCollectorDedupeKey(func(event Event) any {
+		return event.SequenceNumber()
+	}))
+
+	for _, n := range []int{1, 2, 2, 3, 4, 6} {
+		client.EventManager.DispatchEvent(&testEvent{n: n})
+	}
+
+	result := c.Wait(context.Background())
+	assert.Equal(t, CollectorEndReasonLimit, result.Reason)
+	assert.Len(t, result.Items, 2)
+	assert.Equal(t, 2, result.Items[0].n)
+	assert.Equal(t, 4, result.Items[1].n)
+
+	var delivered []int
+	for e := range c.Events() {
+		delivered = append(delivered, e.n)
+	}
+	assert.Equal(t, []int{2, 4}, delivered)
+}
+
+func TestCollector_LimitAsync(t *testing.T) {
+	t.Parallel()
+
+	client := &Client{}
+	client.EventManager = NewEventManager(client, WithAsyncEventsEnabled())
+	c := NewCollector[*testEvent](client, context.Background(), nil, WithCollectorMax(5), WithCollectorBufferSize(100))
+
+	for n := range 100 {
+		client.EventManager.DispatchEvent(&testEvent{n: n})
+	}
+
+	result := c.Wait(context.Background())
+	assert.Equal(t, CollectorEndReasonLimit, result.Reason)
+	assert.Len(t, result.Items, 5)
+
+	var delivered int
+	for range c.Events() {
+		delivered++
+	}
+	assert.Equal(t, 5, delivered)
+}
+
+func TestCollector_IdleTimeout(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient()
+	c := NewCollector[*testEvent](client, context.Background(), nil, WithCollectorIdleTimeout(20*time.Millisecond), WithCollectorBufferSize(0))
+	client.EventManager.DispatchEvent(&testEvent{n: 1})
+
+	result := c.Wait(context.Background())
+	assert.Equal(t, CollectorEndReasonIdle, result.Reason)
+	assert.Len(t, result.Items, 1)
+}
+
+func TestCollector_Cancel(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	c := NewCollector[*testEvent](newTestClient(), ctx, nil)
+	cancel()
+
+	assert.Equal(t, CollectorEndReasonCancelled, c.Wait(context.Background()).Reason)
+}
+
+func TestCollector_BufferedDelivery(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient()
+	c := NewCollector[*testEvent](client, context.Background(), nil, WithCollectorBufferSize(0), WithCollectorDelivery(CollectorDeliveryBuffered))
+
+	dispatched := make(chan struct{})
+	go func() {
+		defer close(dispatched)
+		client.EventManager.DispatchEvent(&testEvent{n: 1})
+	}()
+
+	// the dispatching goroutine blocks on the send without holding the lock of the Collector
+	assert.Eventually(t, func() bool {
+		return len(c.Result().Items) == 1
+	}, time.Second, time.Millisecond)
+	select {
+	case <-dispatched:
+		t.Fatal("dispatch must block until the event is received")
+	default:
+	}
+
+	c.Stop()
+	<-dispatched
+	result := c.Result()
+	assert.Equal(t, CollectorEndReasonStopped, result.Reason)
+	assert.Len(t, result.Items, 1)
+}
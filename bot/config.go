@@ -50,6 +50,16 @@ type config struct {
 
 	MemberChunkingManager MemberChunkingManager
 	MemberChunkingFilter  MemberChunkingFilter
+
+	MemberChunkingScheduler           MemberChunkingScheduler
+	MemberChunkingSchedulerEnabled    bool
+	MemberChunkingSchedulerConfigOpts []MemberChunkingSchedulerConfigOpt
+
+	InteractionHydrator           InteractionHydrator
+	InteractionHydrationEnabled   bool
+	InteractionHydratorConfigOpts []InteractionHydratorConfigOpt
+
+	Modules []Module
 }
 
 // ConfigOpt is a type alias for a function that takes a config and is used to configure your Client.
@@ -206,6 +216,43 @@ func WithMemberChunkingFilter(memberChunkingFilter MemberChunkingFilter) ConfigO
 	}
 }
 
+// WithMemberChunkingScheduler lets you inject your own MemberChunkingScheduler.
+func WithMemberChunkingScheduler(memberChunkingScheduler MemberChunkingScheduler) ConfigOpt {
+	return func(config *config) {
+		config.MemberChunkingScheduler = memberChunkingScheduler
+	}
+}
+
+// WithMemberChunkingSchedulerConfigOpts enables the default MemberChunkingScheduler which chunks the guilds passing the MemberChunkingFilter after GUILD_CREATE.
+func WithMemberChunkingSchedulerConfigOpts(opts ...MemberChunkingSchedulerConfigOpt) ConfigOpt {
+	return func(config *config) {
+		config.MemberChunkingSchedulerEnabled = true
+		config.MemberChunkingSchedulerConfigOpts = append(config.MemberChunkingSchedulerConfigOpts, opts...)
+	}
+}
+
+// WithInteractionHydrator lets you inject your own InteractionHydrator.
+func WithInteractionHydrator(interactionHydrator InteractionHydrator) ConfigOpt {
+	return func(config *config) {
+		config.InteractionHydrator = interactionHydrator
+	}
+}
+
+// WithInteractionHydration enables the default InteractionHydrator which feeds the data of HTTP interactions into the cache.Caches.
+func WithInteractionHydration(opts ...InteractionHydratorConfigOpt) ConfigOpt {
+	return func(config *config) {
+		config.InteractionHydrationEnabled = true
+		config.InteractionHydratorConfigOpts = append(config.InteractionHydratorConfigOpts, opts...)
+	}
+}
+
+// WithModules registers the given Module(s). They are initialized in dependency order while the Client is built.
+func WithModules(modules ...Module) ConfigOpt {
+	return func(config *config) {
+		config.Modules = append(config.Modules, modules...)
+	}
+}
+
 func defaultHTTPServerEventHandlerFunc(client *Client) httpserver.EventHandlerFunc {
 	return client.EventManager.HandleHTTPEvent
 }
@@ -342,10 +389,29 @@ func BuildClient(
 	}
 	client.MemberChunkingManager = cfg.MemberChunkingManager
 
+	if cfg.MemberChunkingScheduler == nil && cfg.MemberChunkingSchedulerEnabled {
+		cfg.MemberChunkingScheduler = NewMemberChunkingScheduler(client, append([]MemberChunkingSchedulerConfigOpt{WithMemberChunkingSchedulerLogger(cfg.Logger)}, cfg.MemberChunkingSchedulerConfigOpts...)...)
+	}
+	client.MemberChunkingScheduler = cfg.MemberChunkingScheduler
+
 	if cfg.Caches == nil {
 		cfg.Caches = cache.New(cfg.CacheConfigOpts...)
 	}
 	client.Caches = cfg.Caches
 
+	if cfg.InteractionHydrator == nil && cfg.InteractionHydrationEnabled {
+		cfg.InteractionHydrator = NewInteractionHydrator(client.Caches, append([]InteractionHydratorConfigOpt{WithInteractionHydratorLogger(cfg.Logger)}, cfg.InteractionHydratorConfigOpts...)...)
+	}
+	client.InteractionHydrator = cfg.InteractionHydrator
+
+	moduleManager, err := newModuleManager(client, cfg.Logger, cfg.Modules)
+	if err != nil {
+		return nil, err
+	}
+	client.Modules = moduleManager
+	if err = moduleManager.init(); err != nil {
+		return nil, err
+	}
+
 	return client, nil
 }
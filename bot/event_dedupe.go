@@ -0,0 +1,128 @@
+package bot
+
+import (
+	"sync"
+	"time"
+
+	"github.com/disgoorg/disgo/gateway"
+)
+
+// DefaultEventDedupeWindow is the default time.Duration in which a gateway event with the same dedupe key is considered a duplicate.
+const DefaultEventDedupeWindow = 5 * time.Minute
+
+// EventDedupeKeyFunc returns the key of a gateway event used to detect duplicates.
+// Events for which ok is false are never deduplicated.
+type EventDedupeKeyFunc func(event gateway.EventData) (key string, ok bool)
+
+// DefaultEventDedupeKeys returns the EventDedupeKeyFunc(s) for create-style gateway events which are only sent once per entity.
+func DefaultEventDedupeKeys() map[gateway.EventType]EventDedupeKeyFunc {
+	return map[gateway.EventType]EventDedupeKeyFunc{
+		gateway.EventTypeMessageCreate: func(event gateway.EventData) (string, bool) {
+			e, ok := event.(gateway.EventMessageCreate)
+			return e.ID.String(), ok
+		},
+		gateway.EventTypeInteractionCreate: func(event gateway.EventData) (string, bool) {
+			e, ok := event.(gateway.EventInteractionCreate)
+			if !ok || e.Interaction == nil {
+				return "", false
+			}
+			return e.Interaction.ID().String(), true
+		},
+		gateway.EventTypeGuildMemberAdd: func(event gateway.EventData) (string, bool) {
+			e, ok := event.(gateway.EventGuildMemberAdd)
+			if !ok || e.JoinedAt == nil {
+				return "", false
+			}
+			// the join time distinguishes a rejoin of the same member from a duplicate
+			return e.GuildID.String() + ":" + e.User.ID.String() + ":" + e.JoinedAt.String(), true
+		},
+		gateway.EventTypeThreadCreate: func(event gateway.EventData) (string, bool) {
+			e, ok := event.(gateway.EventThreadCreate)
+			return e.ID().String(), ok
+		},
+		gateway.EventTypeEntitlementCreate: func(event gateway.EventData) (string, bool) {
+			e, ok := event.(gateway.EventEntitlementCreate)
+			return e.ID.String(), ok
+		},
+		gateway.EventTypeGuildAuditLogEntryCreate: func(event gateway.EventData) (string, bool) {
+			e, ok := event.(gateway.EventGuildAuditLogEntryCreate)
+			return e.ID.String(), ok
+		},
+		gateway.EventTypeLobbyMessageCreate: func(event gateway.EventData) (string, bool) {
+			e, ok := event.(gateway.EventLobbyMessageCreate)
+			return e.ID.String(), ok
+		},
+	}
+}
+
+func newEventDeduper(window time.Duration, maxSize int, keys map[gateway.EventType]EventDedupeKeyFunc) *eventDeduper {
+	return &eventDeduper{
+		window:  window,
+		maxSize: maxSize,
+		keys:    keys,
+		seen:    map[eventDedupeKey]time.Time{},
+	}
+}
+
+type eventDedupeKey struct {
+	eventType gateway.EventType
+	key       string
+}
+
+type eventDedupeEntry struct {
+	key  eventDedupeKey
+	seen time.Time
+}
+
+// eventDeduper remembers the dedupe keys of the last window in insertion order, so expired keys can be evicted from the front.
+type eventDeduper struct {
+	window  time.Duration
+	maxSize int
+	keys    map[gateway.EventType]EventDedupeKeyFunc
+
+	mu    sync.Mutex
+	seen  map[eventDedupeKey]time.Time
+	order []eventDedupeEntry
+}
+
+// duplicate returns true if an event with the same type & key was already seen within the window.
+func (d *eventDeduper) duplicate(eventType gateway.EventType, event gateway.EventData) bool {
+	keyFunc, ok := d.keys[eventType]
+	if !ok {
+		return false
+	}
+	k, ok := keyFunc(event)
+	if !ok {
+		return false
+	}
+	key := eventDedupeKey{eventType: eventType, key: k}
+	now := time.Now()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.evict(now)
+	if _, ok = d.seen[key]; ok {
+		return true
+	}
+	d.seen[key] = now
+	d.order = append(d.order, eventDedupeEntry{key: key, seen: now})
+	d.evict(now)
+	return false
+}
+
+// evict removes all expired keys and the oldest keys exceeding the max size.
+func (d *eventDeduper) evict(now time.Time) {
+	var i int
+	for ; i < len(d.order); i++ {
+		if now.Sub(d.order[i].seen) < d.window && (d.maxSize <= 0 || len(d.order)-i <= d.maxSize) {
+			break
+		}
+		delete(d.seen, d.order[i].key)
+	}
+	if i == len(d.order) {
+		d.order = d.order[:0]
+	} else if i > 0 {
+		// reslicing keeps eviction O(1) per key, the evicted front is dropped once append reallocates
+		d.order = d.order[i:]
+	}
+}
@@ -0,0 +1,64 @@
+package bot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/gateway"
+)
+
+func TestEventManager_Dedupe(t *testing.T) {
+	t.Parallel()
+
+	var handled []int
+	m := NewEventManager(nil,
+		WithEventDedupe(time.Minute, 2),
+		WithGatewayHandlers(map[gateway.EventType]GatewayEventHandler{
+			gateway.EventTypeMessageCreate: NewGatewayEventHandler(gateway.EventTypeMessageCreate, func(_ *Client, sequenceNumber int, _ int, _ gateway.EventMessageCreate) {
+				handled = append(handled, sequenceNumber)
+			}),
+		}),
+	)
+
+	for i, messageID := range []int{1, 2, 1, 3, 1, 3} {
+		m.HandleGatewayEvent(gateway.EventTypeMessageCreate, i, 0, gateway.EventMessageCreate{
+			Message: discord.Message{ID: snowflake.ID(messageID)},
+		})
+	}
+	// message 1 is evicted by message 3 because of the max size
+	assert.Equal(t, []int{0, 1, 3, 4}, handled)
+}
+
+func TestEventDeduper_Window(t *testing.T) {
+	t.Parallel()
+
+	d := newEventDeduper(10*time.Millisecond, 0, DefaultEventDedupeKeys())
+	event := gateway.EventMessageCreate{Message: discord.Message{ID: 1}}
+
+	assert.False(t, d.duplicate(gateway.EventTypeMessageCreate, event))
+	assert.True(t, d.duplicate(gateway.EventTypeMessageCreate, event))
+	assert.False(t, d.duplicate(gateway.EventTypeMessageUpdate, gateway.EventMessageUpdate{Message: discord.Message{ID: 1}}))
+
+	time.Sleep(20 * time.Millisecond)
+	assert.False(t, d.duplicate(gateway.EventTypeMessageCreate, event))
+}
+
+func TestEventDeduper_MaxSize(t *testing.T) {
+	t.Parallel()
+
+	d := newEventDeduper(time.Minute, 100, DefaultEventDedupeKeys())
+	for i := range 10_000 {
+		assert.False(t, d.duplicate(gateway.EventTypeMessageCreate, gateway.EventMessageCreate{Message: discord.Message{ID: snowflake.ID(i)}}))
+	}
+	assert.True(t, d.duplicate(gateway.EventTypeMessageCreate, gateway.EventMessageCreate{Message: discord.Message{ID: 9_999}}))
+	assert.False(t, d.duplicate(gateway.EventTypeMessageCreate, gateway.EventMessageCreate{Message: discord.Message{ID: 9_899}}))
+
+	assert.Len(t, d.seen, 100)
+	assert.Len(t, d.order, 100)
+	// the evicted keys are dropped from the backing array instead of growing it forever
+	assert.LessOrEqual(t, cap(d.order), 1000)
+}
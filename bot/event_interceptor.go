@@ -0,0 +1,37 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+)
+
+// EventHandlerFunc handles an Event with the context.Context of the dispatch.
+type EventHandlerFunc func(ctx context.Context, event Event)
+
+// EventInterceptor runs before the EventListener(s) of an EventManager.
+// It can filter or short-circuit the dispatch by not calling next, pass context values to EventListenerErr(s)
+// by calling next with a derived context.Context or measure the duration of next.
+type EventInterceptor func(ctx context.Context, event Event, next EventHandlerFunc)
+
+// EventErrorHandler receives the errors returned by EventListenerErr(s) and the panics of EventListener(s) & EventInterceptor(s) as PanicError.
+// listener is nil if the error happened in an EventInterceptor.
+type EventErrorHandler func(event Event, listener EventListener, err error)
+
+// NewEventFilter returns an EventInterceptor which only dispatches Event(s) for which filter returns true.
+func NewEventFilter(filter func(event Event) bool) EventInterceptor {
+	return func(ctx context.Context, event Event, next EventHandlerFunc) {
+		if filter(event) {
+			next(ctx, event)
+		}
+	}
+}
+
+// PanicError is a recovered panic of an EventListener or EventInterceptor.
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
@@ -0,0 +1,54 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type ctxKey struct{}
+
+func TestEventManager_Interceptors(t *testing.T) {
+	t.Parallel()
+
+	var (
+		order     []string
+		errs      []error
+		listenErr = errors.New("listener failed")
+	)
+	m := NewEventManager(nil,
+		WithEventErrorHandler(func(_ Event, _ EventListener, err error) {
+			errs = append(errs, err)
+		}),
+		WithEventInterceptors(
+			NewEventFilter(func(event Event) bool {
+				return event.SequenceNumber() != 0
+			}),
+			func(ctx context.Context, event Event, next EventHandlerFunc) {
+				order = append(order, "interceptor")
+				next(context.WithValue(ctx, ctxKey{}, "value"), event)
+			},
+		),
+		WithListenerFuncErr(func(ctx context.Context, _ *testEvent) error {
+			order = append(order, ctx.Value(ctxKey{}).(string))
+			return listenErr
+		}),
+		WithListenerFunc(func(_ *testEvent) {
+			panic("listener panicked")
+		}),
+	)
+
+	m.DispatchEvent(&testEvent{n: 0})
+	assert.Empty(t, order)
+
+	m.DispatchEvent(&testEvent{n: 1})
+	assert.Equal(t, []string{"interceptor", "value"}, order)
+	require.Len(t, errs, 2)
+	assert.ErrorIs(t, errs[0], listenErr)
+	var panicErr *PanicError
+	require.ErrorAs(t, errs[1], &panicErr)
+	assert.Equal(t, "listener panicked", panicErr.Value)
+}
@@ -1,10 +1,17 @@
 package bot
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"log/slog"
+	"reflect"
 	"runtime/debug"
 	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/disgoorg/disgo/discord"
 	"github.com/disgoorg/disgo/gateway"
 	"github.com/disgoorg/disgo/httpserver"
 )
@@ -16,14 +23,31 @@ func NewEventManager(client *Client, opts ...EventManagerConfigOpt) EventManager
 	cfg := defaultEventManagerConfig()
 	cfg.apply(opts)
 
-	return &eventManagerImpl{
+	m := &eventManagerImpl{
 		client:             client,
 		logger:             cfg.Logger,
 		eventListeners:     cfg.EventListeners,
-		asyncEventsEnabled: cfg.AsyncEventsEnabled,
+		eventInterceptors:  cfg.EventInterceptors,
+		eventErrorHandler:  cfg.EventErrorHandler,
+		asyncEventsEnabled: cfg.AsyncEventsEnabled && cfg.EventWorkers <= 0,
+		drainTimeout:       cfg.DrainTimeout,
 		gatewayHandlers:    cfg.GatewayHandlers,
 		httpServerHandler:  cfg.HTTPServerHandler,
 	}
+	if m.eventErrorHandler == nil {
+		m.eventErrorHandler = m.logEventError
+	}
+	if cfg.EventDedupeWindow > 0 {
+		keys := cfg.EventDedupeKeys
+		if keys == nil {
+			keys = DefaultEventDedupeKeys()
+		}
+		m.deduper = newEventDeduper(cfg.EventDedupeWindow, cfg.EventDedupeMaxSize, keys)
+	}
+	if cfg.EventWorkers > 0 {
+		m.workerPool = newEventWorkerPool(cfg.EventWorkers, max(cfg.EventQueueSize, 1), cfg.EventOverflowStrategy, cfg.EventPartitionFunc, m.dispatch, &m.inFlight)
+	}
+	return m
 }
 
 // EventManager lets you listen for specific events triggered by raw Gateway events
@@ -31,9 +55,13 @@ type EventManager interface {
 	// AddEventListeners adds one or more EventListener(s) to the EventManager
 	AddEventListeners(eventListeners ...EventListener)
 
-	// RemoveEventListeners removes one or more EventListener(s) from the EventManager
+	// RemoveEventListeners removes one or more EventListener(s) from the EventManager.
+	// Events which are already being dispatched may still be passed to the removed EventListener(s).
 	RemoveEventListeners(eventListeners ...EventListener)
 
+	// AddEventInterceptors adds one or more EventInterceptor(s) which run in the order they were added before the EventListener(s)
+	AddEventInterceptors(eventInterceptors ...EventInterceptor)
+
 	// HandleGatewayEvent calls the correct GatewayEventHandler for the payload
 	HandleGatewayEvent(gatewayEventType gateway.EventType, sequenceNumber int, shardID int, event gateway.EventData)
 
@@ -42,6 +70,17 @@ type EventManager interface {
 
 	// DispatchEvent dispatches a new Event to the Client's EventListener(s)
 	DispatchEvent(event Event)
+
+	// Go runs f in a new goroutine which Drain waits for
+	Go(f func())
+
+	// Drain stops handling new Gateway & HTTP events and waits until all in-flight gateway handlers, EventListener(s)
+	// and functions started with Go returned, the context.Context is done or the drain timeout passed.
+	// HTTP interactions received while draining are answered with 503 Service Unavailable.
+	Drain(ctx context.Context) error
+
+	// WorkerPoolStats returns a snapshot of the worker pool queues. Workers is empty if WithEventWorkers is not used.
+	WorkerPoolStats() EventWorkerPoolStats
 }
 
 // EventListener is used to create new EventListener to listen to events
@@ -49,6 +88,13 @@ type EventListener interface {
 	OnEvent(event Event)
 }
 
+// EventListenerErr is an EventListener which reports errors to the EventErrorHandler of the EventManager.
+// The EventManager calls OnEventErr instead of OnEvent with the context.Context passed through the EventInterceptor(s).
+type EventListenerErr interface {
+	EventListener
+	OnEventErr(ctx context.Context, event Event) error
+}
+
 // NewListenerFunc returns a new EventListener for the given func(e E)
 func NewListenerFunc[E Event](f func(e E)) EventListener {
 	return &listenerFunc[E]{f: f}
@@ -58,12 +104,40 @@ type listenerFunc[E Event] struct {
 	f func(e E)
 }
 
+func (l *listenerFunc[E]) eventType() reflect.Type {
+	return reflect.TypeFor[E]()
+}
+
 func (l *listenerFunc[E]) OnEvent(e Event) {
 	if event, ok := e.(E); ok {
 		l.f(event)
 	}
 }
 
+// NewListenerFuncErr returns a new EventListenerErr for the given func(ctx context.Context, e E) error
+func NewListenerFuncErr[E Event](f func(ctx context.Context, e E) error) EventListenerErr {
+	return &listenerFuncErr[E]{f: f}
+}
+
+type listenerFuncErr[E Event] struct {
+	f func(ctx context.Context, e E) error
+}
+
+func (l *listenerFuncErr[E]) eventType() reflect.Type {
+	return reflect.TypeFor[E]()
+}
+
+func (l *listenerFuncErr[E]) OnEvent(e Event) {
+	_ = l.OnEventErr(context.Background(), e)
+}
+
+func (l *listenerFuncErr[E]) OnEventErr(ctx context.Context, e Event) error {
+	if event, ok := e.(E); ok {
+		return l.f(ctx, event)
+	}
+	return nil
+}
+
 // NewListenerChan returns a new EventListener for the given chan<- Event
 func NewListenerChan[E Event](c chan<- E) EventListener {
 	return &listenerChan[E]{c: c}
@@ -73,12 +147,36 @@ type listenerChan[E Event] struct {
 	c chan<- E
 }
 
+func (l *listenerChan[E]) eventType() reflect.Type {
+	return reflect.TypeFor[E]()
+}
+
 func (l *listenerChan[E]) OnEvent(e Event) {
 	if event, ok := e.(E); ok {
 		l.c <- event
 	}
 }
 
+// typedEventListener is implemented by EventListener(s) which only handle events of a single type.
+// The EventManager indexes them by event type and only dispatches events assignable to the type. A nil type handles all events.
+type typedEventListener interface {
+	EventListener
+	eventType() reflect.Type
+}
+
+// acceptsEvent returns whether the EventListener handles events of the given type
+func acceptsEvent(listener EventListener, eventType reflect.Type) bool {
+	typed, ok := listener.(typedEventListener)
+	if !ok {
+		return true
+	}
+	listenerType := typed.eventType()
+	if listenerType == nil || eventType == nil {
+		return listenerType == nil
+	}
+	return eventType.AssignableTo(listenerType)
+}
+
 // Event the basic interface each event implement
 type Event interface {
 	Client() *Client
@@ -123,12 +221,30 @@ type eventManagerImpl struct {
 	logger             *slog.Logger
 	eventListenerMu    sync.Mutex
 	eventListeners     []EventListener
+	listenersByType    map[reflect.Type][]EventListener
+	eventInterceptors  []EventInterceptor
+	eventErrorHandler  EventErrorHandler
 	asyncEventsEnabled bool
+	drainTimeout       time.Duration
 	gatewayHandlers    map[gateway.EventType]GatewayEventHandler
 	httpServerHandler  HTTPServerEventHandler
+	workerPool         *eventWorkerPool
+	deduper            *eventDeduper
+	inFlight           inFlight
+	draining           atomic.Bool
 }
 
 func (e *eventManagerImpl) HandleGatewayEvent(gatewayEventType gateway.EventType, sequenceNumber int, shardID int, event gateway.EventData) {
+	if e.draining.Load() {
+		e.logger.Debug("dropping Gateway event while draining", slog.Any("event_type", gatewayEventType))
+		return
+	}
+	if e.deduper != nil && e.deduper.duplicate(gatewayEventType, event) {
+		e.logger.Debug("dropping duplicate Gateway event", slog.Any("event_type", gatewayEventType), slog.Int("sequence", sequenceNumber), slog.Int("shard_id", shardID))
+		return
+	}
+	e.inFlight.add()
+	defer e.inFlight.done()
 	e.mu.Lock()
 	defer e.mu.Unlock()
 	if handler, ok := e.gatewayHandlers[gatewayEventType]; ok {
@@ -139,41 +255,149 @@ func (e *eventManagerImpl) HandleGatewayEvent(gatewayEventType gateway.EventType
 }
 
 func (e *eventManagerImpl) HandleHTTPEvent(respondFunc httpserver.RespondFunc, event httpserver.EventInteractionCreate) {
+	if e.draining.Load() {
+		e.logger.Debug("rejecting HTTP event while draining")
+		_ = respondFunc(discord.InteractionResponse{Type: discord.InteractionResponseTypeUnavailable})
+		return
+	}
+	e.inFlight.add()
+	defer e.inFlight.done()
 	e.mu.Lock()
 	defer e.mu.Unlock()
 	e.httpServerHandler.HandleHTTPEvent(e.client, respondFunc, event)
 }
 
 func (e *eventManagerImpl) DispatchEvent(event Event) {
+	if e.workerPool != nil && e.workerPool.dispatch(event) {
+		return
+	}
+	e.inFlight.add()
+	defer e.inFlight.done()
+	e.dispatch(event)
+}
+
+func (e *eventManagerImpl) Go(f func()) {
+	e.inFlight.add()
+	go func() {
+		defer e.inFlight.done()
+		f()
+	}()
+}
+
+func (e *eventManagerImpl) Drain(ctx context.Context) error {
+	e.draining.Store(true)
+	if e.drainTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, e.drainTimeout)
+		defer cancel()
+	}
+	e.logger.Debug("draining event manager", slog.Int("in_flight", e.inFlight.len()))
+	err := e.inFlight.wait(ctx)
+	if e.workerPool != nil {
+		e.workerPool.close()
+	}
+	return err
+}
+
+func (e *eventManagerImpl) WorkerPoolStats() EventWorkerPoolStats {
+	if e.workerPool == nil {
+		return EventWorkerPoolStats{}
+	}
+	return e.workerPool.stats()
+}
+
+func (e *eventManagerImpl) dispatch(event Event) {
 	defer func() {
 		if r := recover(); r != nil {
-			e.logger.Error("recovered from panic in event listener", slog.Any("arg", r), slog.String("stack", string(debug.Stack())))
-			return
+			e.eventErrorHandler(event, nil, &PanicError{Value: r, Stack: debug.Stack()})
 		}
 	}()
+	listeners, interceptors := e.listenersFor(event)
+
+	next := func(ctx context.Context, event Event) {
+		e.dispatchListeners(ctx, listeners, event)
+	}
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, n := interceptors[i], next
+		next = func(ctx context.Context, event Event) {
+			interceptor(ctx, event, n)
+		}
+	}
+	next(context.Background(), event)
+}
+
+// listenersFor returns the EventListener(s) in the order they were added which handle the event and the current EventInterceptor(s).
+// The EventListener(s) are cached per event type until they change, so dispatching does not iterate all EventListener(s)
+// and the lock is not held while they are called.
+func (e *eventManagerImpl) listenersFor(event Event) ([]EventListener, []EventInterceptor) {
+	eventType := reflect.TypeOf(event)
+
 	e.eventListenerMu.Lock()
 	defer e.eventListenerMu.Unlock()
-	for _, listener := range e.eventListeners {
+	listeners, ok := e.listenersByType[eventType]
+	if !ok {
+		for _, listener := range e.eventListeners {
+			if acceptsEvent(listener, eventType) {
+				listeners = append(listeners, listener)
+			}
+		}
+		if e.listenersByType == nil {
+			e.listenersByType = map[reflect.Type][]EventListener{}
+		}
+		e.listenersByType[eventType] = listeners
+	}
+	return listeners, e.eventInterceptors
+}
+
+func (e *eventManagerImpl) dispatchListeners(ctx context.Context, listeners []EventListener, event Event) {
+	for _, listener := range listeners {
 		if e.asyncEventsEnabled {
+			e.inFlight.add()
 			go func() {
-				defer func() {
-					if r := recover(); r != nil {
-						e.logger.Error("recovered from panic in event listener", slog.Any("arg", r), slog.String("stack", string(debug.Stack())))
-						return
-					}
-				}()
-				listener.OnEvent(event)
+				defer e.inFlight.done()
+				e.callListener(ctx, listener, event)
 			}()
 			continue
 		}
-		listener.OnEvent(event)
+		e.callListener(ctx, listener, event)
 	}
 }
 
+func (e *eventManagerImpl) callListener(ctx context.Context, listener EventListener, event Event) {
+	defer func() {
+		if r := recover(); r != nil {
+			e.eventErrorHandler(event, listener, &PanicError{Value: r, Stack: debug.Stack()})
+		}
+	}()
+	if l, ok := listener.(EventListenerErr); ok {
+		if err := l.OnEventErr(ctx, event); err != nil {
+			e.eventErrorHandler(event, listener, err)
+		}
+		return
+	}
+	listener.OnEvent(event)
+}
+
+func (e *eventManagerImpl) logEventError(event Event, _ EventListener, err error) {
+	var panicErr *PanicError
+	if errors.As(err, &panicErr) {
+		e.logger.Error("recovered from panic in event listener", slog.Any("arg", panicErr.Value), slog.String("stack", string(panicErr.Stack)))
+		return
+	}
+	e.logger.Error("error in event listener", slog.String("event_type", fmt.Sprintf("%T", event)), slog.Any("err", err))
+}
+
+func (e *eventManagerImpl) AddEventInterceptors(interceptors ...EventInterceptor) {
+	e.eventListenerMu.Lock()
+	defer e.eventListenerMu.Unlock()
+	e.eventInterceptors = append(e.eventInterceptors, interceptors...)
+}
+
 func (e *eventManagerImpl) AddEventListeners(listeners ...EventListener) {
 	e.eventListenerMu.Lock()
 	defer e.eventListenerMu.Unlock()
 	e.eventListeners = append(e.eventListeners, listeners...)
+	e.listenersByType = nil
 }
 
 func (e *eventManagerImpl) RemoveEventListeners(listeners ...EventListener) {
@@ -187,4 +411,5 @@ func (e *eventManagerImpl) RemoveEventListeners(listeners ...EventListener) {
 			}
 		}
 	}
+	e.listenersByType = nil
 }
@@ -1,21 +1,42 @@
 package bot
 
 import (
+	"context"
 	"log/slog"
+	"time"
 
 	"github.com/disgoorg/disgo/gateway"
 )
 
+// DefaultDrainTimeout is the default maximum time EventManager.Drain waits for in-flight events.
+// EventListener(s) waiting for further events, like collectors, would otherwise block forever.
+const DefaultDrainTimeout = 10 * time.Second
+
 func defaultEventManagerConfig() eventManagerConfig {
 	return eventManagerConfig{
-		Logger: slog.Default(),
+		Logger:             slog.Default(),
+		DrainTimeout:       DefaultDrainTimeout,
+		EventQueueSize:     1000,
+		EventPartitionFunc: DefaultEventPartitionFunc,
 	}
 }
 
 type eventManagerConfig struct {
 	Logger             *slog.Logger
 	EventListeners     []EventListener
+	EventInterceptors  []EventInterceptor
+	EventErrorHandler  EventErrorHandler
 	AsyncEventsEnabled bool
+	DrainTimeout       time.Duration
+
+	EventWorkers          int
+	EventQueueSize        int
+	EventOverflowStrategy EventOverflowStrategy
+	EventPartitionFunc    EventPartitionFunc
+
+	EventDedupeWindow  time.Duration
+	EventDedupeMaxSize int
+	EventDedupeKeys    map[gateway.EventType]EventDedupeKeyFunc
 
 	GatewayHandlers   map[gateway.EventType]GatewayEventHandler
 	HTTPServerHandler HTTPServerEventHandler
@@ -38,6 +59,14 @@ func WithEventManagerLogger(logger *slog.Logger) EventManagerConfigOpt {
 	}
 }
 
+// WithDrainTimeout sets the maximum time EventManager.Drain waits for in-flight events in addition to its context.Context.
+// A timeout <= 0 only waits for the context.Context. Defaults to DefaultDrainTimeout.
+func WithDrainTimeout(timeout time.Duration) EventManagerConfigOpt {
+	return func(config *eventManagerConfig) {
+		config.DrainTimeout = timeout
+	}
+}
+
 // WithListeners adds the given EventListener(s) to the eventManagerConfig.
 func WithListeners(listeners ...EventListener) EventManagerConfigOpt {
 	return func(config *eventManagerConfig) {
@@ -50,11 +79,31 @@ func WithListenerFunc[E Event](f func(e E)) EventManagerConfigOpt {
 	return WithListeners(NewListenerFunc(f))
 }
 
+// WithListenerFuncErr adds the given func(ctx context.Context, e E) error to the eventManagerConfig.
+func WithListenerFuncErr[E Event](f func(ctx context.Context, e E) error) EventManagerConfigOpt {
+	return WithListeners(NewListenerFuncErr(f))
+}
+
 // WithListenerChan adds the given chan<- E to the eventManagerConfig.
 func WithListenerChan[E Event](c chan<- E) EventManagerConfigOpt {
 	return WithListeners(NewListenerChan(c))
 }
 
+// WithEventInterceptors adds the given EventInterceptor(s) to the eventManagerConfig.
+func WithEventInterceptors(interceptors ...EventInterceptor) EventManagerConfigOpt {
+	return func(config *eventManagerConfig) {
+		config.EventInterceptors = append(config.EventInterceptors, interceptors...)
+	}
+}
+
+// WithEventErrorHandler sets the EventErrorHandler which receives all errors & panics of EventListener(s) and EventInterceptor(s).
+// By default, they are logged.
+func WithEventErrorHandler(handler EventErrorHandler) EventManagerConfigOpt {
+	return func(config *eventManagerConfig) {
+		config.EventErrorHandler = handler
+	}
+}
+
 // WithAsyncEventsEnabled enables/disables the async events.
 func WithAsyncEventsEnabled() EventManagerConfigOpt {
 	return func(config *eventManagerConfig) {
@@ -62,6 +111,53 @@ func WithAsyncEventsEnabled() EventManagerConfigOpt {
 	}
 }
 
+// WithEventWorkers enables dispatching events with a fixed pool of workers instead of calling the EventListener(s) directly.
+// Events are partitioned by the EventPartitionFunc, so events of the same guild are handled in order while different guilds are handled in parallel.
+// It takes precedence over WithAsyncEventsEnabled.
+// Dispatching an Event from an EventListener to a full queue of the same worker with EventOverflowBlock deadlocks.
+func WithEventWorkers(workers int) EventManagerConfigOpt {
+	return func(config *eventManagerConfig) {
+		config.EventWorkers = workers
+	}
+}
+
+// WithEventQueueSize sets the maximum number of queued events per worker. Defaults to 1000.
+func WithEventQueueSize(size int) EventManagerConfigOpt {
+	return func(config *eventManagerConfig) {
+		config.EventQueueSize = size
+	}
+}
+
+// WithEventOverflowStrategy sets the EventOverflowStrategy used when a worker queue is full. Defaults to EventOverflowBlock.
+func WithEventOverflowStrategy(strategy EventOverflowStrategy) EventManagerConfigOpt {
+	return func(config *eventManagerConfig) {
+		config.EventOverflowStrategy = strategy
+	}
+}
+
+// WithEventPartitionFunc overrides the default EventPartitionFunc used to pick the worker of an Event.
+func WithEventPartitionFunc(partitionFunc EventPartitionFunc) EventManagerConfigOpt {
+	return func(config *eventManagerConfig) {
+		config.EventPartitionFunc = partitionFunc
+	}
+}
+
+// WithEventDedupe enables dropping gateway events which were already received within the window, for example after a resume or a shard restart.
+// At most maxSize keys are remembered, 0 means no limit. Only the event types of WithEventDedupeKeys or DefaultEventDedupeKeys are deduplicated.
+func WithEventDedupe(window time.Duration, maxSize int) EventManagerConfigOpt {
+	return func(config *eventManagerConfig) {
+		config.EventDedupeWindow = window
+		config.EventDedupeMaxSize = maxSize
+	}
+}
+
+// WithEventDedupeKeys overrides the EventDedupeKeyFunc(s) used by WithEventDedupe.
+func WithEventDedupeKeys(keys map[gateway.EventType]EventDedupeKeyFunc) EventManagerConfigOpt {
+	return func(config *eventManagerConfig) {
+		config.EventDedupeKeys = keys
+	}
+}
+
 // WithGatewayHandlers overrides the default GatewayEventHandler(s) in the eventManagerConfig.
 func WithGatewayHandlers(handlers map[gateway.EventType]GatewayEventHandler) EventManagerConfigOpt {
 	return func(config *eventManagerConfig) {
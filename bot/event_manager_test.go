@@ -0,0 +1,77 @@
+package bot
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/gateway"
+	"github.com/disgoorg/disgo/httpserver"
+)
+
+func TestEventManager_Drain(t *testing.T) {
+	t.Parallel()
+
+	var (
+		handled  atomic.Int32
+		finished atomic.Bool
+	)
+	release := make(chan struct{})
+	m := NewEventManager(nil,
+		WithAsyncEventsEnabled(),
+		WithGatewayHandlers(map[gateway.EventType]GatewayEventHandler{
+			gateway.EventTypeTypingStart: NewGatewayEventHandler(gateway.EventTypeTypingStart, func(_ *Client, _ int, _ int, _ gateway.EventTypingStart) {
+				handled.Add(1)
+			}),
+		}),
+		WithListenerFunc(func(_ *testEvent) {
+			<-release
+		}),
+	)
+	m.DispatchEvent(&testEvent{})
+	m.Go(func() {
+		<-release
+		time.Sleep(10 * time.Millisecond)
+		finished.Store(true)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, m.Drain(ctx), context.DeadlineExceeded)
+
+	m.HandleGatewayEvent(gateway.EventTypeTypingStart, 0, 0, gateway.EventTypingStart{})
+	assert.Zero(t, handled.Load())
+
+	close(release)
+	assert.NoError(t, m.Drain(context.Background()))
+	assert.True(t, finished.Load())
+}
+
+func TestEventManager_DrainTimeout(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	defer close(release)
+	m := NewEventManager(nil,
+		WithDrainTimeout(10*time.Millisecond),
+		WithAsyncEventsEnabled(),
+		WithListenerFunc(func(_ *testEvent) {
+			// like a collector waiting for an event which never arrives
+			<-release
+		}),
+	)
+	m.DispatchEvent(&testEvent{})
+
+	assert.ErrorIs(t, m.Drain(context.Background()), context.DeadlineExceeded)
+
+	var response discord.InteractionResponse
+	m.HandleHTTPEvent(func(r discord.InteractionResponse) error {
+		response = r
+		return nil
+	}, httpserver.EventInteractionCreate{})
+	assert.Equal(t, discord.InteractionResponseTypeUnavailable, response.Type)
+}
@@ -0,0 +1,148 @@
+package bot
+
+import (
+	"reflect"
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+var (
+	_ EventListenerRegistry = (EventManager)(nil)
+	_ EventListenerRegistry = (*ListenerGroup)(nil)
+)
+
+// EventListenerRegistry adds & removes EventListener(s). It is implemented by EventManager and ListenerGroup.
+type EventListenerRegistry interface {
+	// AddEventListeners adds one or more EventListener(s)
+	AddEventListeners(eventListeners ...EventListener)
+	// RemoveEventListeners removes one or more EventListener(s)
+	RemoveEventListeners(eventListeners ...EventListener)
+}
+
+// Subscribe adds a listener for events of type E to the EventListenerRegistry and returns a Subscription to remove it again.
+//
+//	sub := bot.Subscribe(client.EventManager, func(e *events.MessageCreate) {
+//		// handle event
+//	})
+//	defer sub.Unsubscribe()
+func Subscribe[E Event](registry EventListenerRegistry, f func(e E)) *Subscription {
+	return subscribe(registry, f, false)
+}
+
+// Once adds a listener for events of type E to the EventListenerRegistry which is removed after the first event.
+// The returned Subscription can be used to remove it before any event was received.
+func Once[E Event](registry EventListenerRegistry, f func(e E)) *Subscription {
+	return subscribe(registry, f, true)
+}
+
+func subscribe[E Event](registry EventListenerRegistry, f func(e E), once bool) *Subscription {
+	s := &Subscription{registry: registry}
+	s.listener = &subscriptionListener[E]{subscription: s, f: f, once: once}
+	s.active.Store(true)
+	registry.AddEventListeners(s.listener)
+	return s
+}
+
+// Subscription is the handle of a listener added with Subscribe or Once
+type Subscription struct {
+	registry EventListenerRegistry
+	listener EventListener
+	active   atomic.Bool
+}
+
+// Unsubscribe removes the listener. Calling it multiple times is a no-op.
+// With async events or event workers, events which are already being dispatched may still be delivered to the listener.
+func (s *Subscription) Unsubscribe() {
+	if s.active.CompareAndSwap(true, false) {
+		s.registry.RemoveEventListeners(s.listener)
+	}
+}
+
+// Active returns whether the listener was not unsubscribed yet. A listener added with Once is inactive after its first event.
+func (s *Subscription) Active() bool {
+	return s.active.Load()
+}
+
+// Listener returns the EventListener which was added to the EventListenerRegistry
+func (s *Subscription) Listener() EventListener {
+	return s.listener
+}
+
+type subscriptionListener[E Event] struct {
+	subscription *Subscription
+	f            func(e E)
+	once         bool
+}
+
+func (l *subscriptionListener[E]) eventType() reflect.Type {
+	return reflect.TypeFor[E]()
+}
+
+func (l *subscriptionListener[E]) OnEvent(e Event) {
+	event, ok := e.(E)
+	if !ok {
+		return
+	}
+	if l.once {
+		if !l.subscription.active.CompareAndSwap(true, false) {
+			return
+		}
+		l.subscription.registry.RemoveEventListeners(l)
+	} else if !l.subscription.active.Load() {
+		return
+	}
+	l.f(event)
+}
+
+// NewListenerGroup returns a new ListenerGroup which adds its EventListener(s) to the given EventListenerRegistry
+func NewListenerGroup(registry EventListenerRegistry) *ListenerGroup {
+	return &ListenerGroup{registry: registry}
+}
+
+// ListenerGroup is an EventListenerRegistry which keeps track of the EventListener(s) added through it,
+// so they can be removed together. For example a Module can add its listeners to a ListenerGroup in Module.Init
+// and call RemoveAll in Module.Stop. Use it with Subscribe & Once to add typed listeners.
+type ListenerGroup struct {
+	registry EventListenerRegistry
+
+	mu        sync.Mutex
+	listeners []EventListener
+}
+
+func (g *ListenerGroup) AddEventListeners(listeners ...EventListener) {
+	g.mu.Lock()
+	g.listeners = append(g.listeners, listeners...)
+	g.mu.Unlock()
+	g.registry.AddEventListeners(listeners...)
+}
+
+func (g *ListenerGroup) RemoveEventListeners(listeners ...EventListener) {
+	g.mu.Lock()
+	for _, listener := range listeners {
+		if i := slices.Index(g.listeners, listener); i >= 0 {
+			g.listeners = slices.Delete(g.listeners, i, i+1)
+		}
+	}
+	g.mu.Unlock()
+	g.registry.RemoveEventListeners(listeners...)
+}
+
+// Len returns the number of EventListener(s) in the ListenerGroup
+func (g *ListenerGroup) Len() int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return len(g.listeners)
+}
+
+// RemoveAll removes all EventListener(s) of the ListenerGroup from its EventListenerRegistry.
+// The ListenerGroup can be reused afterwards.
+func (g *ListenerGroup) RemoveAll() {
+	g.mu.Lock()
+	listeners := g.listeners
+	g.listeners = nil
+	g.mu.Unlock()
+	if len(listeners) > 0 {
+		g.registry.RemoveEventListeners(listeners...)
+	}
+}
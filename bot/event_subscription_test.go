@@ -0,0 +1,73 @@
+package bot
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type otherTestEvent struct {
+	testEvent
+}
+
+func TestSubscribe(t *testing.T) {
+	t.Parallel()
+
+	m := NewEventManager(nil)
+	var received, once []int
+	sub := Subscribe(m, func(e *testEvent) {
+		received = append(received, e.n)
+	})
+	Once(m, func(e *testEvent) {
+		once = append(once, e.n)
+		// unsubscribing while dispatching must not deadlock
+		sub.Unsubscribe()
+	})
+
+	m.DispatchEvent(&otherTestEvent{testEvent{n: 0}})
+	m.DispatchEvent(&testEvent{n: 1})
+	m.DispatchEvent(&testEvent{n: 2})
+
+	assert.Equal(t, []int{1}, received)
+	assert.Equal(t, []int{1}, once)
+	assert.False(t, sub.Active())
+
+	listeners, _ := m.(*eventManagerImpl).listenersFor(&testEvent{})
+	assert.Empty(t, listeners)
+}
+
+func TestListenerGroup(t *testing.T) {
+	t.Parallel()
+
+	m := NewEventManager(nil)
+	var received []int
+	all := NewListenerFunc(func(e Event) {
+		received = append(received, e.SequenceNumber())
+	})
+	m.AddEventListeners(all)
+
+	group := NewListenerGroup(m)
+	Subscribe(group, func(e *testEvent) {
+		received = append(received, e.n*10)
+	})
+	Subscribe(group, func(e *otherTestEvent) {
+		received = append(received, e.n*100)
+	})
+	assert.Equal(t, 2, group.Len())
+
+	impl := m.(*eventManagerImpl)
+	listeners, _ := impl.listenersFor(&testEvent{})
+	assert.Len(t, listeners, 2)
+	assert.Equal(t, all, listeners[0])
+	assert.Len(t, impl.listenersByType, 1)
+
+	m.DispatchEvent(&otherTestEvent{testEvent{n: 1}})
+	group.RemoveAll()
+	m.DispatchEvent(&otherTestEvent{testEvent{n: 2}})
+
+	assert.Equal(t, []int{1, 100, 2}, received)
+	assert.Equal(t, 0, group.Len())
+	assert.Len(t, impl.eventListeners, 1)
+	assert.Equal(t, reflect.TypeFor[Event](), all.(typedEventListener).eventType())
+}
@@ -0,0 +1,301 @@
+package bot
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// EventOverflowStrategy decides what happens when an Event is dispatched to a full worker queue.
+type EventOverflowStrategy int
+
+const (
+	// EventOverflowBlock blocks the dispatching goroutine until the worker queue has space again.
+	EventOverflowBlock EventOverflowStrategy = iota
+	// EventOverflowDropOldest drops the oldest queued Event of the worker to make space for the new one.
+	EventOverflowDropOldest
+	// EventOverflowDropNewest drops the dispatched Event.
+	EventOverflowDropNewest
+)
+
+func (s EventOverflowStrategy) String() string {
+	switch s {
+	case EventOverflowBlock:
+		return "block"
+	case EventOverflowDropOldest:
+		return "drop_oldest"
+	case EventOverflowDropNewest:
+		return "drop_newest"
+	default:
+		return "unknown"
+	}
+}
+
+// EventPartitionFunc returns the partition key of an Event.
+// Events with the same partition key are handled by the same worker in the order they were dispatched.
+type EventPartitionFunc func(event Event) uint64
+
+// DefaultEventPartitionFunc partitions Event(s) by their guild ID.
+// Events without a guild ID are partitioned by their channel ID and then by their shard ID.
+func DefaultEventPartitionFunc(event Event) uint64 {
+	if id := eventGuildID(event); id != 0 {
+		return uint64(id)
+	}
+	if id := eventChannelID(event); id != 0 {
+		return uint64(id)
+	}
+	if e, ok := event.(interface{ ShardID() int }); ok {
+		return uint64(e.ShardID())
+	}
+	return 0
+}
+
+// EventWorkerPoolStats is a snapshot of the worker pool of an EventManager.
+type EventWorkerPoolStats struct {
+	OverflowStrategy EventOverflowStrategy `json:"overflow_strategy"`
+	Workers          []EventWorkerStats    `json:"workers"`
+}
+
+// QueueDepth returns the number of queued Event(s) across all workers.
+func (s EventWorkerPoolStats) QueueDepth() int {
+	var depth int
+	for _, worker := range s.Workers {
+		depth += worker.QueueDepth
+	}
+	return depth
+}
+
+// Dropped returns the number of dropped Event(s) across all workers.
+func (s EventWorkerPoolStats) Dropped() uint64 {
+	var dropped uint64
+	for _, worker := range s.Workers {
+		dropped += worker.Dropped
+	}
+	return dropped
+}
+
+// EventWorkerStats is a snapshot of a single worker of the EventManager worker pool.
+type EventWorkerStats struct {
+	QueueDepth    int    `json:"queue_depth"`
+	MaxQueueDepth int    `json:"max_queue_depth"`
+	QueueSize     int    `json:"queue_size"`
+	Processed     uint64 `json:"processed"`
+	Dropped       uint64 `json:"dropped"`
+}
+
+func newEventWorkerPool(workers int, queueSize int, strategy EventOverflowStrategy, partitionFunc EventPartitionFunc, handle func(event Event), inFlight *inFlight) *eventWorkerPool {
+	pool := &eventWorkerPool{
+		strategy:      strategy,
+		partitionFunc: partitionFunc,
+		workers:       make([]*eventWorker, workers),
+	}
+	for i := range pool.workers {
+		worker := &eventWorker{
+			inFlight:  inFlight,
+			queueSize: queueSize,
+			queue:     make([]Event, 0, queueSize),
+		}
+		worker.cond = sync.NewCond(&worker.mu)
+		pool.workers[i] = worker
+		go worker.run(handle)
+	}
+	return pool
+}
+
+type eventWorkerPool struct {
+	strategy      EventOverflowStrategy
+	partitionFunc EventPartitionFunc
+	workers       []*eventWorker
+}
+
+// dispatch queues the Event to the worker of its partition. It returns false if the pool is closed.
+func (p *eventWorkerPool) dispatch(event Event) bool {
+	return p.workers[p.workerIndex(event)].enqueue(event, p.strategy)
+}
+
+// workerIndex returns the index of the worker of the Event's partition
+func (p *eventWorkerPool) workerIndex(event Event) int {
+	return int(mixPartitionKey(p.partitionFunc(event)) % uint64(len(p.workers)))
+}
+
+// mixPartitionKey spreads the bits of the partition key with the splitmix64 finalizer.
+// The low bits of snowflakes are the increment which is mostly 0, so the raw key would put most guilds on the same worker.
+func mixPartitionKey(key uint64) uint64 {
+	key ^= key >> 30
+	key *= 0xbf58476d1ce4e5b9
+	key ^= key >> 27
+	key *= 0x94d049bb133111eb
+	key ^= key >> 31
+	return key
+}
+
+// close stops all workers once their queues are empty.
+func (p *eventWorkerPool) close() {
+	for _, worker := range p.workers {
+		worker.close()
+	}
+}
+
+func (p *eventWorkerPool) stats() EventWorkerPoolStats {
+	stats := EventWorkerPoolStats{
+		OverflowStrategy: p.strategy,
+		Workers:          make([]EventWorkerStats, len(p.workers)),
+	}
+	for i, worker := range p.workers {
+		stats.Workers[i] = worker.stats()
+	}
+	return stats
+}
+
+type eventWorker struct {
+	inFlight      *inFlight
+	mu            sync.Mutex
+	cond          *sync.Cond
+	closed        bool
+	queueSize     int
+	queue         []Event
+	maxQueueDepth int
+	processed     uint64
+	dropped       uint64
+}
+
+func (w *eventWorker) enqueue(event Event, strategy EventOverflowStrategy) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for !w.closed && len(w.queue) >= w.queueSize {
+		switch strategy {
+		case EventOverflowDropNewest:
+			w.dropped++
+			return true
+		case EventOverflowDropOldest:
+			w.queue[0] = nil
+			w.queue = w.queue[1:]
+			w.dropped++
+			w.inFlight.done()
+		default:
+			w.cond.Wait()
+		}
+	}
+	if w.closed {
+		return false
+	}
+	w.inFlight.add()
+	w.queue = append(w.queue, event)
+	w.maxQueueDepth = max(w.maxQueueDepth, len(w.queue))
+	w.cond.Broadcast()
+	return true
+}
+
+func (w *eventWorker) close() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.closed = true
+	w.cond.Broadcast()
+}
+
+func (w *eventWorker) run(handle func(event Event)) {
+	for {
+		w.mu.Lock()
+		for !w.closed && len(w.queue) == 0 {
+			w.cond.Wait()
+		}
+		if len(w.queue) == 0 {
+			w.mu.Unlock()
+			return
+		}
+		event := w.queue[0]
+		w.queue[0] = nil
+		w.queue = w.queue[1:]
+		w.cond.Broadcast()
+		w.mu.Unlock()
+
+		handle(event)
+		w.inFlight.done()
+
+		w.mu.Lock()
+		w.processed++
+		w.mu.Unlock()
+	}
+}
+
+func (w *eventWorker) stats() EventWorkerStats {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return EventWorkerStats{
+		QueueDepth:    len(w.queue),
+		MaxQueueDepth: w.maxQueueDepth,
+		QueueSize:     w.queueSize,
+		Processed:     w.processed,
+		Dropped:       w.dropped,
+	}
+}
+
+var eventPartitionFields sync.Map // map[reflect.Type]eventIDFields
+
+type eventIDFields struct {
+	guildID   []int
+	channelID []int
+}
+
+func eventFields(t reflect.Type) eventIDFields {
+	if fields, ok := eventPartitionFields.Load(t); ok {
+		return fields.(eventIDFields)
+	}
+	var fields eventIDFields
+	if t.Kind() == reflect.Pointer && t.Elem().Kind() == reflect.Struct {
+		if f, ok := t.Elem().FieldByName("GuildID"); ok {
+			fields.guildID = f.Index
+		}
+		if f, ok := t.Elem().FieldByName("ChannelID"); ok {
+			fields.channelID = f.Index
+		}
+	}
+	eventPartitionFields.Store(t, fields)
+	return fields
+}
+
+func eventGuildID(event Event) snowflake.ID {
+	if id := eventFieldID(event, eventFields(reflect.TypeOf(event)).guildID); id != 0 {
+		return id
+	}
+	if e, ok := event.(interface{ GuildID() *snowflake.ID }); ok {
+		if id := e.GuildID(); id != nil {
+			return *id
+		}
+	}
+	return 0
+}
+
+func eventChannelID(event Event) snowflake.ID {
+	if id := eventFieldID(event, eventFields(reflect.TypeOf(event)).channelID); id != 0 {
+		return id
+	}
+	if e, ok := event.(interface{ ChannelID() snowflake.ID }); ok {
+		return e.ChannelID()
+	}
+	return 0
+}
+
+func eventFieldID(event Event, index []int) snowflake.ID {
+	if index == nil {
+		return 0
+	}
+	v := reflect.ValueOf(event)
+	if v.IsNil() {
+		return 0
+	}
+	field, err := v.Elem().FieldByIndexErr(index)
+	if err != nil || !field.CanInterface() {
+		return 0
+	}
+	switch id := field.Interface().(type) {
+	case snowflake.ID:
+		return id
+	case *snowflake.ID:
+		if id != nil {
+			return *id
+		}
+	}
+	return 0
+}
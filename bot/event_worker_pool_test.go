@@ -0,0 +1,143 @@
+package bot
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEvent struct {
+	GuildID   *snowflake.ID
+	ChannelID snowflake.ID
+	n         int
+}
+
+func (e *testEvent) Client() *Client     { return nil }
+func (e *testEvent) SequenceNumber() int { return e.n }
+
+func TestDefaultEventPartitionFunc(t *testing.T) {
+	t.Parallel()
+
+	guildID := snowflake.ID(1)
+	assert.Equal(t, uint64(1), DefaultEventPartitionFunc(&testEvent{GuildID: &guildID, ChannelID: 2}))
+	assert.Equal(t, uint64(2), DefaultEventPartitionFunc(&testEvent{ChannelID: 2}))
+	assert.Equal(t, uint64(0), DefaultEventPartitionFunc(&testEvent{}))
+}
+
+func TestEventManager_WorkerPoolOrdering(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		events = map[snowflake.ID][]int{}
+	)
+	m := NewEventManager(nil,
+		WithEventWorkers(4),
+		WithListenerFunc(func(e *testEvent) {
+			defer wg.Done()
+			mu.Lock()
+			defer mu.Unlock()
+			events[*e.GuildID] = append(events[*e.GuildID], e.n)
+		}),
+	)
+
+	guildIDs := []snowflake.ID{81384788765712384, 222078108977594368, 302094807046684672, 287285563118190592, 1, 2, 3, 4}
+	for i := range 100 {
+		for _, guildID := range guildIDs {
+			wg.Add(1)
+			m.DispatchEvent(&testEvent{GuildID: &guildID, n: i})
+		}
+	}
+	wg.Wait()
+
+	for _, guildID := range guildIDs {
+		require.Len(t, events[guildID], 100)
+		for i, n := range events[guildID] {
+			assert.Equal(t, i, n)
+		}
+	}
+	assert.Equal(t, uint64(800), func() uint64 {
+		var processed uint64
+		for _, worker := range m.WorkerPoolStats().Workers {
+			processed += worker.Processed
+		}
+		return processed
+	}())
+}
+
+func TestEventWorkerPool_Spread(t *testing.T) {
+	t.Parallel()
+
+	pool := newEventWorkerPool(8, 1, EventOverflowBlock, DefaultEventPartitionFunc, func(Event) {}, &inFlight{})
+	defer pool.close()
+
+	// realistic snowflakes have an increment of 0 in their low bits
+	counts := make([]int, len(pool.workers))
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := range 800 {
+		guildID := snowflake.New(start.Add(time.Duration(i) * 37 * time.Minute))
+		counts[pool.workerIndex(&testEvent{GuildID: &guildID})]++
+	}
+	for i, count := range counts {
+		assert.InDelta(t, 100, count, 40, "worker %d", i)
+	}
+}
+
+func TestEventManager_WorkerPoolOverflow(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		strategy EventOverflowStrategy
+		expected []int
+	}{
+		{strategy: EventOverflowDropOldest, expected: []int{0, 3, 4}},
+		{strategy: EventOverflowDropNewest, expected: []int{0, 1, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.strategy.String(), func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				mu      sync.Mutex
+				handled []int
+			)
+			block := make(chan struct{})
+			started := make(chan struct{}, 1)
+			m := NewEventManager(nil,
+				WithEventWorkers(1),
+				WithEventQueueSize(2),
+				WithEventOverflowStrategy(tt.strategy),
+				WithListenerFunc(func(e *testEvent) {
+					if e.n == 0 {
+						started <- struct{}{}
+						<-block
+					}
+					mu.Lock()
+					defer mu.Unlock()
+					handled = append(handled, e.n)
+				}),
+			)
+
+			m.DispatchEvent(&testEvent{n: 0})
+			<-started
+			for i := 1; i < 5; i++ {
+				m.DispatchEvent(&testEvent{n: i})
+			}
+			stats := m.WorkerPoolStats()
+			assert.Equal(t, 2, stats.QueueDepth())
+			assert.Equal(t, uint64(2), stats.Dropped())
+			close(block)
+
+			assert.Eventually(t, func() bool {
+				mu.Lock()
+				defer mu.Unlock()
+				return assert.ObjectsAreEqual(tt.expected, handled)
+			}, time.Second, time.Millisecond)
+		})
+	}
+}
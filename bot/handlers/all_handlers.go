@@ -91,6 +91,16 @@ var allEventHandlers = []bot.GatewayEventHandler{
 	bot.NewGatewayEventHandler(gateway.EventTypeInviteCreate, gatewayHandlerInviteCreate),
 	bot.NewGatewayEventHandler(gateway.EventTypeInviteDelete, gatewayHandlerInviteDelete),
 
+	bot.NewGatewayEventHandler(gateway.EventTypeLobbyCreate, gatewayHandlerLobbyCreate),
+	bot.NewGatewayEventHandler(gateway.EventTypeLobbyUpdate, gatewayHandlerLobbyUpdate),
+	bot.NewGatewayEventHandler(gateway.EventTypeLobbyDelete, gatewayHandlerLobbyDelete),
+	bot.NewGatewayEventHandler(gateway.EventTypeLobbyMemberAdd, gatewayHandlerLobbyMemberAdd),
+	bot.NewGatewayEventHandler(gateway.EventTypeLobbyMemberUpdate, gatewayHandlerLobbyMemberUpdate),
+	bot.NewGatewayEventHandler(gateway.EventTypeLobbyMemberRemove, gatewayHandlerLobbyMemberRemove),
+	bot.NewGatewayEventHandler(gateway.EventTypeLobbyMessageCreate, gatewayHandlerLobbyMessageCreate),
+	bot.NewGatewayEventHandler(gateway.EventTypeLobbyMessageUpdate, gatewayHandlerLobbyMessageUpdate),
+	bot.NewGatewayEventHandler(gateway.EventTypeLobbyMessageDelete, gatewayHandlerLobbyMessageDelete),
+
 	bot.NewGatewayEventHandler(gateway.EventTypeMessageCreate, gatewayHandlerMessageCreate),
 	bot.NewGatewayEventHandler(gateway.EventTypeMessageUpdate, gatewayHandlerMessageUpdate),
 	bot.NewGatewayEventHandler(gateway.EventTypeMessageDelete, gatewayHandlerMessageDelete),
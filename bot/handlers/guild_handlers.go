@@ -4,6 +4,8 @@ import (
 	"context"
 	"log/slog"
 
+	"github.com/disgoorg/snowflake/v2"
+
 	"github.com/disgoorg/disgo/bot"
 	"github.com/disgoorg/disgo/discord"
 	"github.com/disgoorg/disgo/events"
@@ -84,14 +86,7 @@ func gatewayHandlerGuildCreate(client *bot.Client, sequenceNumber int, shardID i
 				GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
 			})
 		}
-		if client.MemberChunkingManager.MemberChunkingFilter()(event.ID) {
-			go func() {
-				if _, err := client.MemberChunkingManager.RequestMembersWithQuery(context.Background(), event.ID, "", 0); err != nil {
-					client.Logger.Error("failed to chunk guild on guild_create", slog.Any("err", err))
-				}
-			}()
-		}
-
+		chunkGuildMembers(client, sequenceNumber, shardID, event.ID)
 		return
 	}
 	if wasUnavailable {
@@ -106,6 +101,33 @@ func gatewayHandlerGuildCreate(client *bot.Client, sequenceNumber int, shardID i
 			Guild:        event.GatewayGuild,
 		})
 	}
+	if client.MemberChunkingScheduler != nil {
+		chunkGuildMembers(client, sequenceNumber, shardID, event.ID)
+	}
+}
+
+func chunkGuildMembers(client *bot.Client, sequenceNumber int, shardID int, guildID snowflake.ID) {
+	if !client.MemberChunkingManager.MemberChunkingFilter()(guildID) {
+		return
+	}
+	if client.MemberChunkingScheduler == nil {
+		go func() {
+			if _, err := client.MemberChunkingManager.RequestMembersWithQuery(context.Background(), guildID, "", 0); err != nil {
+				client.Logger.Error("failed to chunk guild on guild_create", slog.Any("err", err))
+			}
+		}()
+		return
+	}
+	client.MemberChunkingScheduler.Schedule(shardID, guildID, func(progress bot.GuildChunkingProgress) {
+		client.EventManager.DispatchEvent(&events.GuildMembersChunked{
+			GenericGuild: &events.GenericGuild{
+				GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+				GuildID:      guildID,
+			},
+			MemberCount: progress.Members,
+			Attempts:    progress.Attempts,
+		})
+	})
 }
 
 func gatewayHandlerGuildUpdate(client *bot.Client, sequenceNumber int, shardID int, event gateway.EventGuildUpdate) {
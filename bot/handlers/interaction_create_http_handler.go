@@ -24,5 +24,8 @@ func (h *httpserverHandlerInteractionCreate) HandleHTTPEvent(client *bot.Client,
 		}
 		return
 	}
+	if client.InteractionHydrator != nil {
+		client.InteractionHydrator.Hydrate(event.Interaction)
+	}
 	handleInteraction(client, -1, -1, respondFunc, event.Interaction)
 }
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/disgo/events"
+	"github.com/disgoorg/disgo/gateway"
+)
+
+func gatewayHandlerLobbyCreate(client *bot.Client, sequenceNumber int, shardID int, event gateway.EventLobbyCreate) {
+	client.EventManager.DispatchEvent(&events.LobbyCreate{
+		GenericLobby: &events.GenericLobby{
+			GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+			Lobby:        event.Lobby,
+		},
+	})
+}
+
+func gatewayHandlerLobbyUpdate(client *bot.Client, sequenceNumber int, shardID int, event gateway.EventLobbyUpdate) {
+	client.EventManager.DispatchEvent(&events.LobbyUpdate{
+		GenericLobby: &events.GenericLobby{
+			GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+			Lobby:        event.Lobby,
+		},
+	})
+}
+
+func gatewayHandlerLobbyDelete(client *bot.Client, sequenceNumber int, shardID int, event gateway.EventLobbyDelete) {
+	client.EventManager.DispatchEvent(&events.LobbyDelete{
+		GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+		LobbyID:      event.ID,
+	})
+}
+
+func gatewayHandlerLobbyMemberAdd(client *bot.Client, sequenceNumber int, shardID int, event gateway.EventLobbyMemberAdd) {
+	client.EventManager.DispatchEvent(&events.LobbyMemberAdd{
+		GenericLobbyMember: &events.GenericLobbyMember{
+			GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+			LobbyID:      event.LobbyID,
+			Member:       event.Member,
+		},
+	})
+}
+
+func gatewayHandlerLobbyMemberUpdate(client *bot.Client, sequenceNumber int, shardID int, event gateway.EventLobbyMemberUpdate) {
+	client.EventManager.DispatchEvent(&events.LobbyMemberUpdate{
+		GenericLobbyMember: &events.GenericLobbyMember{
+			GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+			LobbyID:      event.LobbyID,
+			Member:       event.Member,
+		},
+	})
+}
+
+func gatewayHandlerLobbyMemberRemove(client *bot.Client, sequenceNumber int, shardID int, event gateway.EventLobbyMemberRemove) {
+	client.EventManager.DispatchEvent(&events.LobbyMemberRemove{
+		GenericLobbyMember: &events.GenericLobbyMember{
+			GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+			LobbyID:      event.LobbyID,
+			Member:       event.Member,
+		},
+	})
+}
+
+func gatewayHandlerLobbyMessageCreate(client *bot.Client, sequenceNumber int, shardID int, event gateway.EventLobbyMessageCreate) {
+	client.EventManager.DispatchEvent(&events.LobbyMessageCreate{
+		GenericLobbyMessage: &events.GenericLobbyMessage{
+			GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+			Message:      event.LobbyMessage,
+		},
+	})
+}
+
+func gatewayHandlerLobbyMessageUpdate(client *bot.Client, sequenceNumber int, shardID int, event gateway.EventLobbyMessageUpdate) {
+	client.EventManager.DispatchEvent(&events.LobbyMessageUpdate{
+		GenericLobbyMessage: &events.GenericLobbyMessage{
+			GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+			Message:      event.LobbyMessage,
+		},
+	})
+}
+
+func gatewayHandlerLobbyMessageDelete(client *bot.Client, sequenceNumber int, shardID int, event gateway.EventLobbyMessageDelete) {
+	client.EventManager.DispatchEvent(&events.LobbyMessageDelete{
+		GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
+		MessageID:    event.ID,
+		LobbyID:      event.LobbyID,
+	})
+}
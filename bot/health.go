@@ -0,0 +1,159 @@
+package bot
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/disgoorg/json/v2"
+
+	"github.com/disgoorg/disgo/gateway"
+	"github.com/disgoorg/disgo/rest"
+	"github.com/disgoorg/disgo/sharding"
+)
+
+// DefaultHeartbeatAckTimeout is a sensible duration after which a ready shard without a heartbeat ack is considered dead.
+const DefaultHeartbeatAckTimeout = 2 * time.Minute
+
+// Health is a snapshot of the health of a Client.
+type Health struct {
+	// Live is false if any ready shard did not receive a heartbeat ack within the configured timeout.
+	Live bool `json:"live"`
+	// Ready is true once every shard reached gateway.StatusReady and all guilds are loaded.
+	Ready             bool               `json:"ready"`
+	Shards            []ShardHealth      `json:"shards"`
+	UnreadyGuilds     int                `json:"unready_guilds"`
+	UnavailableGuilds int                `json:"unavailable_guilds"`
+	RateLimiter       *RateLimiterHealth `json:"rate_limiter,omitempty"`
+}
+
+// ShardHealth is a snapshot of the health of a single gateway.Gateway.
+type ShardHealth struct {
+	ShardID           int            `json:"shard_id"`
+	Status            gateway.Status `json:"status"`
+	StatusName        string         `json:"status_name"`
+	Latency           time.Duration  `json:"latency"`
+	LastHeartbeatAck  time.Time      `json:"last_heartbeat_ack"`
+	UnreadyGuilds     int            `json:"unready_guilds"`
+	UnavailableGuilds int            `json:"unavailable_guilds"`
+}
+
+// RateLimiterHealth is a snapshot of the rest.RateLimiter state.
+type RateLimiterHealth struct {
+	rest.RateLimiterStats
+	Saturation float64 `json:"saturation"`
+}
+
+// Health returns a snapshot of the health of the Client's gateway.Gateway or sharding.ShardManager, cache.GuildCache & rest.RateLimiter.
+// A ready shard which did not receive a heartbeat ack within heartbeatAckTimeout is considered dead, if it implements gateway.HeartbeatAckTracker.
+// The rate limiter is only included if it implements rest.RateLimiterStatsProvider.
+func (c *Client) Health(heartbeatAckTimeout time.Duration) Health {
+	var shards []gateway.Gateway
+	if c.HasGateway() {
+		shards = append(shards, c.Gateway)
+	} else if c.HasShardManager() {
+		for shard := range c.ShardManager.Shards() {
+			shards = append(shards, shard)
+		}
+	}
+
+	health := Health{
+		Live:   true,
+		Ready:  len(shards) > 0,
+		Shards: make([]ShardHealth, len(shards)),
+	}
+	shardIndex := make(map[int]int, len(shards))
+	now := time.Now()
+	for i, shard := range shards {
+		status := shard.Status()
+		var lastHeartbeatAck time.Time
+		tracker, tracksHeartbeatAcks := shard.(gateway.HeartbeatAckTracker)
+		if tracksHeartbeatAcks {
+			lastHeartbeatAck = tracker.LastHeartbeatAck()
+		}
+		health.Shards[i] = ShardHealth{
+			ShardID:          shard.ShardID(),
+			Status:           status,
+			StatusName:       status.String(),
+			Latency:          shard.Latency(),
+			LastHeartbeatAck: lastHeartbeatAck,
+		}
+		shardIndex[shard.ShardID()] = i
+
+		if status != gateway.StatusReady {
+			health.Ready = false
+		} else if tracksHeartbeatAcks && heartbeatAckTimeout > 0 && now.Sub(lastHeartbeatAck) > heartbeatAckTimeout {
+			health.Live = false
+		}
+	}
+
+	if c.Caches != nil {
+		unreadyGuildIDs := c.Caches.UnreadyGuildIDs()
+		unavailableGuildIDs := c.Caches.UnavailableGuildIDs()
+		health.UnreadyGuilds = len(unreadyGuildIDs)
+		health.UnavailableGuilds = len(unavailableGuildIDs)
+		if health.UnreadyGuilds > 0 {
+			health.Ready = false
+		}
+
+		if len(shards) > 0 {
+			shardCount := shards[0].ShardCount()
+			for _, guildID := range unreadyGuildIDs {
+				if i, ok := shardIndex[sharding.ShardIDByGuild(guildID, shardCount)]; ok {
+					health.Shards[i].UnreadyGuilds++
+				}
+			}
+			for _, guildID := range unavailableGuildIDs {
+				if i, ok := shardIndex[sharding.ShardIDByGuild(guildID, shardCount)]; ok {
+					health.Shards[i].UnavailableGuilds++
+				}
+			}
+		}
+	}
+
+	if c.Rest != nil {
+		if provider, ok := c.Rest.RateLimiter().(rest.RateLimiterStatsProvider); ok {
+			stats := provider.Stats()
+			health.RateLimiter = &RateLimiterHealth{
+				RateLimiterStats: stats,
+				Saturation:       stats.Saturation(),
+			}
+		}
+	}
+
+	return health
+}
+
+// NewHealthHandler returns a http.Handler which serves liveness, readiness & health information of the Client.
+//
+//   - GET /livez responds with 200 if Health.Live is true and 503 otherwise
+//   - GET /readyz responds with 200 if Health.Ready is true and 503 otherwise
+//   - GET /healthz responds with the full Health as json
+//
+// A ready shard which did not receive a heartbeat ack within heartbeatAckTimeout is considered dead. Use 0 to disable this check.
+func NewHealthHandler(client *Client, heartbeatAckTimeout time.Duration) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /livez", func(w http.ResponseWriter, r *http.Request) {
+		writeHealthStatus(w, client.Health(heartbeatAckTimeout).Live)
+	})
+	mux.HandleFunc("GET /readyz", func(w http.ResponseWriter, r *http.Request) {
+		writeHealthStatus(w, client.Health(heartbeatAckTimeout).Ready)
+	})
+	mux.HandleFunc("GET /healthz", func(w http.ResponseWriter, r *http.Request) {
+		health := client.Health(heartbeatAckTimeout)
+		w.Header().Set("Content-Type", "application/json")
+		if !health.Live {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_ = json.NewEncoder(w).Encode(health)
+	})
+	return mux
+}
+
+func writeHealthStatus(w http.ResponseWriter, ok bool) {
+	if !ok {
+		http.Error(w, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("ok"))
+}
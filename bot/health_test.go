@@ -0,0 +1,111 @@
+package bot
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/disgoorg/json/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/disgoorg/disgo/cache"
+	"github.com/disgoorg/disgo/gateway"
+	"github.com/disgoorg/disgo/rest"
+)
+
+type healthTestGateway struct {
+	gateway.Gateway
+	status gateway.Status
+}
+
+func (g *healthTestGateway) ShardID() int           { return 0 }
+func (g *healthTestGateway) ShardCount() int        { return 1 }
+func (g *healthTestGateway) Status() gateway.Status { return g.status }
+func (g *healthTestGateway) Latency() time.Duration { return time.Millisecond }
+
+type healthTestAckGateway struct {
+	healthTestGateway
+	lastHeartbeatAck time.Time
+}
+
+func (g *healthTestAckGateway) LastHeartbeatAck() time.Time { return g.lastHeartbeatAck }
+
+func getHealth(t *testing.T, handler http.Handler, path string) (int, string) {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	return rec.Code, rec.Body.String()
+}
+
+func TestHealthHandler(t *testing.T) {
+	t.Parallel()
+
+	shard := &healthTestAckGateway{
+		healthTestGateway: healthTestGateway{status: gateway.StatusReady},
+		lastHeartbeatAck:  time.Now(),
+	}
+	rateLimiter := rest.NewRateLimiter()
+	t.Cleanup(func() { rateLimiter.Close(context.Background()) })
+	client := &Client{
+		Gateway: shard,
+		Caches:  cache.New(),
+		Rest:    rest.New(rest.NewClient("", rest.WithRateLimiter(rateLimiter))),
+	}
+	handler := NewHealthHandler(client, time.Minute)
+
+	code, body := getHealth(t, handler, "/livez")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "ok", body)
+	code, _ = getHealth(t, handler, "/readyz")
+	assert.Equal(t, http.StatusOK, code)
+
+	code, body = getHealth(t, handler, "/healthz")
+	assert.Equal(t, http.StatusOK, code)
+	var health Health
+	require.NoError(t, json.Unmarshal([]byte(body), &health))
+	assert.True(t, health.Live)
+	assert.True(t, health.Ready)
+	require.Len(t, health.Shards, 1)
+	assert.Equal(t, gateway.StatusReady, health.Shards[0].Status)
+	assert.NotNil(t, health.RateLimiter)
+
+	// unready guilds make the client unready but not dead
+	client.Caches.SetGuildUnready(1, true)
+	code, _ = getHealth(t, handler, "/readyz")
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	code, _ = getHealth(t, handler, "/livez")
+	assert.Equal(t, http.StatusOK, code)
+
+	shard.lastHeartbeatAck = time.Now().Add(-time.Hour)
+	code, _ = getHealth(t, handler, "/livez")
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	code, body = getHealth(t, handler, "/healthz")
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	require.NoError(t, json.Unmarshal([]byte(body), &health))
+	assert.False(t, health.Live)
+	assert.Equal(t, 1, health.UnreadyGuilds)
+	assert.Equal(t, 1, health.Shards[0].UnreadyGuilds)
+}
+
+func TestHealth_OptionalInterfaces(t *testing.T) {
+	t.Parallel()
+
+	client := &Client{
+		Gateway: &healthTestGateway{status: gateway.StatusReady},
+		Rest:    rest.New(rest.NewClient("", rest.WithRateLimiter(rest.NewNoopRateLimiter()))),
+	}
+
+	// a gateway.Gateway without heartbeat acks and a rest.RateLimiter without stats are not considered unhealthy
+	health := client.Health(time.Minute)
+	assert.True(t, health.Live)
+	assert.True(t, health.Ready)
+	assert.True(t, health.Shards[0].LastHeartbeatAck.IsZero())
+	assert.Nil(t, health.RateLimiter)
+
+	code, _ := getHealth(t, NewHealthHandler(client, time.Minute), "/livez")
+	assert.Equal(t, http.StatusOK, code)
+}
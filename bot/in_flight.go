@@ -0,0 +1,56 @@
+package bot
+
+import (
+	"context"
+	"sync"
+)
+
+// inFlight counts running work like event listeners so it can be awaited with a context.Context.
+type inFlight struct {
+	mu    sync.Mutex
+	count int
+	idle  chan struct{}
+}
+
+func (f *inFlight) add() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.count == 0 {
+		f.idle = make(chan struct{})
+	}
+	f.count++
+}
+
+func (f *inFlight) done() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.count--
+	if f.count == 0 {
+		close(f.idle)
+	}
+}
+
+func (f *inFlight) len() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.count
+}
+
+// wait blocks until no work is running anymore or the context.Context is done.
+func (f *inFlight) wait(ctx context.Context) error {
+	for {
+		f.mu.Lock()
+		if f.count == 0 {
+			f.mu.Unlock()
+			return nil
+		}
+		idle := f.idle
+		f.mu.Unlock()
+
+		select {
+		case <-idle:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,255 @@
+package bot
+
+import (
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/disgoorg/snowflake/v2"
+
+	"github.com/disgoorg/disgo/cache"
+	"github.com/disgoorg/disgo/discord"
+)
+
+var _ InteractionHydrator = (*interactionHydratorImpl)(nil)
+
+// NewInteractionHydrator returns a new InteractionHydrator which hydrates the given cache.Caches.
+func NewInteractionHydrator(caches cache.Caches, opts ...InteractionHydratorConfigOpt) InteractionHydrator {
+	cfg := defaultInteractionHydratorConfig()
+	cfg.apply(opts)
+
+	return &interactionHydratorImpl{
+		config:      cfg,
+		caches:      caches,
+		permissions: map[[2]snowflake.ID]discord.Permissions{},
+		expiries:    map[hydratedEntry]time.Time{},
+		queues:      map[HydrationKind][]hydratedEntry{},
+	}
+}
+
+// InteractionHydrator feeds the entities and permissions interactions carry into the cache.Caches.
+// It is meant for bots without a gateway connection, where the caches would stay empty otherwise.
+// Hydrated entities are removed again after the TTL of the HydrationTTLPolicy.
+type InteractionHydrator interface {
+	// Hydrate caches the invoking member, the channel, the message and the resolved data of the discord.Interaction.
+	Hydrate(interaction discord.Interaction)
+
+	// MemberPermissions returns the permissions of the member in the channel as provided by the latest interaction including overwrites.
+	MemberPermissions(channelID snowflake.ID, userID snowflake.ID) (discord.Permissions, bool)
+
+	// AppPermissions returns the permissions of the application in the channel as provided by the latest interaction.
+	AppPermissions(channelID snowflake.ID) (discord.Permissions, bool)
+
+	// Sweep removes the expired entities. It is also called by Hydrate.
+	Sweep()
+}
+
+// hydratedEntry is a hydrated entity identified by its HydrationKind and IDs.
+// Permissions are identified by the channel ID and the user ID, which is 0 for the application.
+type hydratedEntry struct {
+	kind      HydrationKind
+	id        [2]snowflake.ID
+	expiresAt time.Time
+}
+
+type interactionHydratorImpl struct {
+	config interactionHydratorConfig
+	caches cache.Caches
+
+	mu          sync.Mutex
+	permissions map[[2]snowflake.ID]discord.Permissions
+	expiries    map[hydratedEntry]time.Time
+	// queues holds the entries per HydrationKind in the order they expire, as all entries of a kind share the same TTL
+	queues map[HydrationKind][]hydratedEntry
+}
+
+func (h *interactionHydratorImpl) Hydrate(interaction discord.Interaction) {
+	now := time.Now()
+	h.Sweep()
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var guildID snowflake.ID
+	if id := interaction.GuildID(); id != nil {
+		guildID = *id
+	}
+	channel := interaction.Channel()
+	var channelID snowflake.ID
+	if channel.MessageChannel != nil {
+		channelID = channel.ID()
+	}
+	var userID snowflake.ID
+	if member := interaction.Member(); member != nil {
+		userID = member.User.ID
+		member.GuildID = guildID
+		h.addMember(now, *member)
+		h.addPermissions(now, channelID, userID, member.Permissions)
+	} else {
+		userID = interaction.User().ID
+	}
+	if appPermissions := interaction.AppPermissions(); appPermissions != nil {
+		h.addPermissions(now, channelID, 0, *appPermissions)
+	}
+	if guildChannel, ok := channel.MessageChannel.(discord.GuildChannel); ok && h.ttl(HydrationKindChannels) > 0 {
+		h.caches.AddChannel(guildChannel)
+		h.track(now, HydrationKindChannels, guildChannel.ID(), 0)
+	}
+
+	var resolved discord.ResolvedData
+	switch i := interaction.(type) {
+	case discord.ApplicationCommandInteraction:
+		switch data := i.Data.(type) {
+		case discord.SlashCommandInteractionData:
+			resolved = data.Resolved
+		case discord.UserCommandInteractionData:
+			resolved.Users = data.Resolved.Users
+			resolved.Members = data.Resolved.Members
+		case discord.MessageCommandInteractionData:
+			for _, message := range data.Resolved.Messages {
+				h.addMessage(now, message)
+			}
+		}
+	case discord.ComponentInteraction:
+		h.addMessage(now, i.Message)
+		switch data := i.Data.(type) {
+		case discord.UserSelectMenuInteractionData:
+			resolved.Users = data.Resolved.Users
+			resolved.Members = data.Resolved.Members
+		case discord.RoleSelectMenuInteractionData:
+			resolved.Roles = data.Resolved.Roles
+		case discord.MentionableSelectMenuInteractionData:
+			resolved.Users = data.Resolved.Users
+			resolved.Members = data.Resolved.Members
+			resolved.Roles = data.Resolved.Roles
+		case discord.ChannelSelectMenuInteractionData:
+			resolved.Channels = data.Resolved.Channels
+		}
+	case discord.ModalSubmitInteraction:
+		if i.Message != nil {
+			h.addMessage(now, *i.Message)
+		}
+	}
+
+	if guildID == 0 {
+		return
+	}
+	for id, member := range resolved.Members {
+		if user, ok := resolved.Users[id]; ok {
+			member.User = user
+		}
+		member.GuildID = guildID
+		h.addMember(now, member)
+		h.addPermissions(now, channelID, id, member.Permissions)
+	}
+	if h.ttl(HydrationKindRoles) > 0 {
+		for _, role := range resolved.Roles {
+			role.GuildID = guildID
+			h.caches.AddRole(role)
+			h.track(now, HydrationKindRoles, guildID, role.ID)
+		}
+	}
+	for id, resolvedChannel := range resolved.Channels {
+		// the permissions of resolved channels are the permissions of the invoking user
+		h.addPermissions(now, id, userID, resolvedChannel.Permissions)
+	}
+}
+
+func (h *interactionHydratorImpl) MemberPermissions(channelID snowflake.ID, userID snowflake.ID) (discord.Permissions, bool) {
+	return h.getPermissions(channelID, userID)
+}
+
+func (h *interactionHydratorImpl) AppPermissions(channelID snowflake.ID) (discord.Permissions, bool) {
+	return h.getPermissions(channelID, 0)
+}
+
+func (h *interactionHydratorImpl) Sweep() {
+	now := time.Now()
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for kind, queue := range h.queues {
+		var i int
+		for ; i < len(queue); i++ {
+			entry := queue[i]
+			if now.Before(entry.expiresAt) {
+				break
+			}
+			key := hydratedEntry{kind: entry.kind, id: entry.id}
+			// the entity was hydrated again after this entry, so it is not expired yet
+			if h.expiries[key] != entry.expiresAt {
+				continue
+			}
+			delete(h.expiries, key)
+			h.remove(entry)
+		}
+		if i == len(queue) {
+			delete(h.queues, kind)
+		} else if i > 0 {
+			h.queues[kind] = queue[i:]
+		}
+	}
+}
+
+func (h *interactionHydratorImpl) remove(entry hydratedEntry) {
+	switch entry.kind {
+	case HydrationKindMembers:
+		h.caches.RemoveMember(entry.id[0], entry.id[1])
+	case HydrationKindRoles:
+		h.caches.RemoveRole(entry.id[0], entry.id[1])
+	case HydrationKindChannels:
+		h.caches.RemoveChannel(entry.id[0])
+	case HydrationKindMessages:
+		h.caches.RemoveMessage(entry.id[0], entry.id[1])
+	case HydrationKindPermissions:
+		delete(h.permissions, entry.id)
+		return
+	}
+	h.config.Logger.Debug("removed expired hydrated entity", slog.Int("kind", int(entry.kind)), slog.Any("id", entry.id))
+}
+
+func (h *interactionHydratorImpl) getPermissions(channelID snowflake.ID, userID snowflake.ID) (discord.Permissions, bool) {
+	id := [2]snowflake.ID{channelID, userID}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	permissions, ok := h.permissions[id]
+	if !ok || time.Now().After(h.expiries[hydratedEntry{kind: HydrationKindPermissions, id: id}]) {
+		return 0, false
+	}
+	return permissions, true
+}
+
+func (h *interactionHydratorImpl) ttl(kind HydrationKind) time.Duration {
+	return h.config.TTLPolicy(kind)
+}
+
+func (h *interactionHydratorImpl) addMember(now time.Time, member discord.ResolvedMember) {
+	if h.ttl(HydrationKindMembers) <= 0 || member.GuildID == 0 || member.User.ID == 0 {
+		return
+	}
+	h.caches.AddMember(member.Member)
+	h.track(now, HydrationKindMembers, member.GuildID, member.User.ID)
+}
+
+func (h *interactionHydratorImpl) addMessage(now time.Time, message discord.Message) {
+	if h.ttl(HydrationKindMessages) <= 0 || message.ID == 0 {
+		return
+	}
+	h.caches.AddMessage(message)
+	h.track(now, HydrationKindMessages, message.ChannelID, message.ID)
+}
+
+func (h *interactionHydratorImpl) addPermissions(now time.Time, channelID snowflake.ID, userID snowflake.ID, permissions discord.Permissions) {
+	if h.ttl(HydrationKindPermissions) <= 0 || channelID == 0 {
+		return
+	}
+	h.permissions[[2]snowflake.ID{channelID, userID}] = permissions
+	h.track(now, HydrationKindPermissions, channelID, userID)
+}
+
+func (h *interactionHydratorImpl) track(now time.Time, kind HydrationKind, id0 snowflake.ID, id1 snowflake.ID) {
+	expiresAt := now.Add(h.ttl(kind))
+	key := hydratedEntry{kind: kind, id: [2]snowflake.ID{id0, id1}}
+	h.expiries[key] = expiresAt
+	h.queues[kind] = append(h.queues[kind], hydratedEntry{kind: kind, id: key.id, expiresAt: expiresAt})
+}
@@ -0,0 +1,71 @@
+package bot
+
+import (
+	"log/slog"
+	"time"
+)
+
+// DefaultHydrationTTL is the default time.Duration hydrated entities stay cached.
+const DefaultHydrationTTL = 10 * time.Minute
+
+// HydrationKind is a kind of entity an InteractionHydrator caches.
+type HydrationKind int
+
+const (
+	// HydrationKindMembers are the invoking and resolved discord.Member(s).
+	HydrationKindMembers HydrationKind = iota
+	// HydrationKindRoles are the resolved discord.Role(s).
+	HydrationKindRoles
+	// HydrationKindChannels is the discord.GuildChannel the interaction was invoked in.
+	HydrationKindChannels
+	// HydrationKindMessages are the discord.Message(s) of components and resolved messages of message commands.
+	HydrationKindMessages
+	// HydrationKindPermissions are the interaction-provided permissions of members and the application in channels.
+	HydrationKindPermissions
+)
+
+// HydrationTTLPolicy returns how long hydrated entities of the HydrationKind stay cached. A TTL <= 0 disables the kind.
+type HydrationTTLPolicy func(kind HydrationKind) time.Duration
+
+// HydrationTTL returns a HydrationTTLPolicy which caches all kinds for the given time.Duration.
+func HydrationTTL(ttl time.Duration) HydrationTTLPolicy {
+	return func(HydrationKind) time.Duration {
+		return ttl
+	}
+}
+
+func defaultInteractionHydratorConfig() interactionHydratorConfig {
+	return interactionHydratorConfig{
+		Logger:    slog.Default(),
+		TTLPolicy: HydrationTTL(DefaultHydrationTTL),
+	}
+}
+
+type interactionHydratorConfig struct {
+	Logger    *slog.Logger
+	TTLPolicy HydrationTTLPolicy
+}
+
+// InteractionHydratorConfigOpt is a functional option for configuring an InteractionHydrator.
+type InteractionHydratorConfigOpt func(config *interactionHydratorConfig)
+
+func (c *interactionHydratorConfig) apply(opts []InteractionHydratorConfigOpt) {
+	for _, opt := range opts {
+		opt(c)
+	}
+	c.Logger = c.Logger.With(slog.String("name", "bot_interaction_hydrator"))
+}
+
+// WithInteractionHydratorLogger overrides the default Logger in the interactionHydratorConfig.
+func WithInteractionHydratorLogger(logger *slog.Logger) InteractionHydratorConfigOpt {
+	return func(config *interactionHydratorConfig) {
+		config.Logger = logger
+	}
+}
+
+// WithHydrationTTLPolicy overrides the default HydrationTTLPolicy in the interactionHydratorConfig.
+func WithHydrationTTLPolicy(policy HydrationTTLPolicy) InteractionHydratorConfigOpt {
+	return func(config *interactionHydratorConfig) {
+		config.TTLPolicy = policy
+	}
+}
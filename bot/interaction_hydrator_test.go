@@ -0,0 +1,110 @@
+package bot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/disgoorg/disgo/cache"
+	"github.com/disgoorg/disgo/discord"
+)
+
+const testSlashCommandInteraction = `{
+	"id": "1",
+	"application_id": "2",
+	"type": 2,
+	"token": "token",
+	"version": 1,
+	"guild_id": "3",
+	"channel": {"id": "4", "guild_id": "3", "type": 0, "name": "general"},
+	"app_permissions": "2048",
+	"member": {"user": {"id": "5", "username": "invoker"}, "roles": [], "permissions": "3072"},
+	"data": {
+		"id": "6",
+		"name": "test",
+		"type": 1,
+		"options": [{"name": "user", "type": 6, "value": "7"}, {"name": "role", "type": 8, "value": "8"}],
+		"resolved": {
+			"users": {"7": {"id": "7", "username": "target"}},
+			"members": {"7": {"roles": ["8"], "permissions": "1024"}},
+			"roles": {"8": {"id": "8", "name": "role"}}
+		}
+	}
+}`
+
+func TestInteractionHydrator(t *testing.T) {
+	t.Parallel()
+
+	interaction, err := discord.UnmarshalInteraction([]byte(testSlashCommandInteraction))
+	require.NoError(t, err)
+
+	caches := cache.New(cache.WithCaches(cache.FlagsAll))
+	ttl := 20 * time.Millisecond
+	h := NewInteractionHydrator(caches, WithHydrationTTLPolicy(func(kind HydrationKind) time.Duration {
+		if kind == HydrationKindRoles {
+			return 0
+		}
+		return ttl
+	}))
+	h.Hydrate(interaction)
+
+	member, ok := caches.Member(3, 7)
+	require.True(t, ok)
+	assert.Equal(t, "target", member.User.Username)
+	_, ok = caches.Member(3, 5)
+	assert.True(t, ok)
+	_, ok = caches.Channel(4)
+	assert.True(t, ok)
+	_, ok = caches.Role(3, 8)
+	assert.False(t, ok)
+
+	permissions, ok := h.MemberPermissions(4, 7)
+	require.True(t, ok)
+	assert.Equal(t, discord.PermissionViewChannel, permissions)
+	permissions, ok = h.AppPermissions(4)
+	require.True(t, ok)
+	assert.Equal(t, discord.PermissionSendMessages, permissions)
+
+	time.Sleep(2 * ttl)
+	h.Sweep()
+	_, ok = caches.Member(3, 7)
+	assert.False(t, ok)
+	_, ok = h.MemberPermissions(4, snowflake.ID(7))
+	assert.False(t, ok)
+}
+
+func TestInteractionHydrator_MixedTTL(t *testing.T) {
+	t.Parallel()
+
+	interaction, err := discord.UnmarshalInteraction([]byte(testSlashCommandInteraction))
+	require.NoError(t, err)
+
+	caches := cache.New(cache.WithCaches(cache.FlagsAll))
+	ttl := 20 * time.Millisecond
+	h := NewInteractionHydrator(caches, WithHydrationTTLPolicy(func(kind HydrationKind) time.Duration {
+		if kind == HydrationKindMembers {
+			return time.Hour
+		}
+		return ttl
+	}))
+	h.Hydrate(interaction)
+
+	time.Sleep(2 * ttl)
+	h.Sweep()
+
+	// members are hydrated first but must not keep the shorter lived entries from expiring
+	_, ok := caches.Member(3, 7)
+	assert.True(t, ok)
+	_, ok = caches.Channel(4)
+	assert.False(t, ok)
+
+	impl := h.(*interactionHydratorImpl)
+	impl.mu.Lock()
+	defer impl.mu.Unlock()
+	assert.Empty(t, impl.permissions)
+	assert.Len(t, impl.queues, 1)
+	assert.Len(t, impl.queues[HydrationKindMembers], 2)
+}
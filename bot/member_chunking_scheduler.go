@@ -0,0 +1,261 @@
+package bot
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/disgoorg/snowflake/v2"
+
+	"github.com/disgoorg/disgo/discord"
+)
+
+var _ MemberChunkingScheduler = (*memberChunkingSchedulerImpl)(nil)
+
+// GuildChunkingStatus is the status of the member chunking of a guild.
+type GuildChunkingStatus int
+
+const (
+	// GuildChunkingStatusQueued means the guild waits for a free chunking slot of its shard.
+	GuildChunkingStatusQueued GuildChunkingStatus = iota
+	// GuildChunkingStatusRequesting means the members of the guild are currently requested.
+	GuildChunkingStatusRequesting
+	// GuildChunkingStatusComplete means all members of the guild were received.
+	GuildChunkingStatusComplete
+	// GuildChunkingStatusFailed means the chunking of the guild failed after all retries.
+	GuildChunkingStatusFailed
+)
+
+func (s GuildChunkingStatus) String() string {
+	switch s {
+	case GuildChunkingStatusQueued:
+		return "queued"
+	case GuildChunkingStatusRequesting:
+		return "requesting"
+	case GuildChunkingStatusComplete:
+		return "complete"
+	case GuildChunkingStatusFailed:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
+
+// GuildChunkingProgress is the progress of the member chunking of a guild.
+type GuildChunkingProgress struct {
+	GuildID     snowflake.ID
+	ShardID     int
+	Status      GuildChunkingStatus
+	Members     int
+	Attempts    int
+	QueuedAt    time.Time
+	CompletedAt time.Time
+	Err         error
+}
+
+// NewMemberChunkingScheduler returns a new MemberChunkingScheduler which requests the members with the Client's MemberChunkingManager.
+func NewMemberChunkingScheduler(client *Client, opts ...MemberChunkingSchedulerConfigOpt) MemberChunkingScheduler {
+	return newMemberChunkingScheduler(func(ctx context.Context, guildID snowflake.ID, onMember func()) error {
+		_, err := client.MemberChunkingManager.RequestMembersWithFilter(ctx, guildID, func(discord.Member) bool {
+			onMember()
+			// members are cached by the MemberChunkingManager, so there is no need to collect them
+			return false
+		})
+		return err
+	}, opts...)
+}
+
+// MemberChunkingScheduler requests all members of guilds in the background while limiting the concurrent requests per shard.
+// Discord only accepts a single guild ID per Request Guild Members payload, so each guild is requested on its own.
+// Requests which do not receive all chunks within the timeout are retried with a new nonce.
+type MemberChunkingScheduler interface {
+	// Schedule queues the chunking of all members of the guild. onComplete is called once all members were received.
+	// Guilds which are already queued or requested are ignored.
+	Schedule(shardID int, guildID snowflake.ID, onComplete func(progress GuildChunkingProgress))
+	// Progress returns the GuildChunkingProgress of the guild.
+	Progress(guildID snowflake.ID) (GuildChunkingProgress, bool)
+	// Pending returns the number of queued and requesting guilds.
+	Pending() int
+	// Close cancels all queued and running chunk requests. Their guilds are marked as GuildChunkingStatusFailed.
+	Close()
+}
+
+type scheduledGuild struct {
+	guildID    snowflake.ID
+	onComplete func(progress GuildChunkingProgress)
+}
+
+type shardChunkingQueue struct {
+	guilds []scheduledGuild
+	active int
+}
+
+func newMemberChunkingScheduler(request func(ctx context.Context, guildID snowflake.ID, onMember func()) error, opts ...MemberChunkingSchedulerConfigOpt) *memberChunkingSchedulerImpl {
+	cfg := defaultMemberChunkingSchedulerConfig()
+	cfg.apply(opts)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &memberChunkingSchedulerImpl{
+		config:   cfg,
+		request:  request,
+		ctx:      ctx,
+		cancel:   cancel,
+		shards:   map[int]*shardChunkingQueue{},
+		progress: map[snowflake.ID]*GuildChunkingProgress{},
+	}
+}
+
+type memberChunkingSchedulerImpl struct {
+	config  memberChunkingSchedulerConfig
+	request func(ctx context.Context, guildID snowflake.ID, onMember func()) error
+	ctx     context.Context
+	cancel  context.CancelFunc
+
+	mu       sync.Mutex
+	shards   map[int]*shardChunkingQueue
+	progress map[snowflake.ID]*GuildChunkingProgress
+}
+
+func (s *memberChunkingSchedulerImpl) Schedule(shardID int, guildID snowflake.ID, onComplete func(progress GuildChunkingProgress)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ctx.Err() != nil {
+		return
+	}
+	if progress, ok := s.progress[guildID]; ok && (progress.Status == GuildChunkingStatusQueued || progress.Status == GuildChunkingStatusRequesting) {
+		return
+	}
+	s.progress[guildID] = &GuildChunkingProgress{
+		GuildID:  guildID,
+		ShardID:  shardID,
+		Status:   GuildChunkingStatusQueued,
+		QueuedAt: time.Now(),
+	}
+
+	queue, ok := s.shards[shardID]
+	if !ok {
+		queue = &shardChunkingQueue{}
+		s.shards[shardID] = queue
+	}
+	queue.guilds = append(queue.guilds, scheduledGuild{guildID: guildID, onComplete: onComplete})
+	if queue.active < max(s.config.ConcurrencyPerShard, 1) {
+		queue.active++
+		go s.work(queue)
+	}
+}
+
+func (s *memberChunkingSchedulerImpl) Progress(guildID snowflake.ID) (GuildChunkingProgress, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	progress, ok := s.progress[guildID]
+	if !ok {
+		return GuildChunkingProgress{}, false
+	}
+	return *progress, true
+}
+
+func (s *memberChunkingSchedulerImpl) Pending() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var pending int
+	for _, progress := range s.progress {
+		if progress.Status == GuildChunkingStatusQueued || progress.Status == GuildChunkingStatusRequesting {
+			pending++
+		}
+	}
+	return pending
+}
+
+func (s *memberChunkingSchedulerImpl) Close() {
+	s.cancel()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	for _, queue := range s.shards {
+		for _, guild := range queue.guilds {
+			progress := s.progress[guild.guildID]
+			progress.Status = GuildChunkingStatusFailed
+			progress.Err = s.ctx.Err()
+			progress.CompletedAt = now
+		}
+		queue.guilds = nil
+	}
+}
+
+func (s *memberChunkingSchedulerImpl) work(queue *shardChunkingQueue) {
+	for {
+		s.mu.Lock()
+		if len(queue.guilds) == 0 || s.ctx.Err() != nil {
+			queue.active--
+			s.mu.Unlock()
+			return
+		}
+		guild := queue.guilds[0]
+		queue.guilds = queue.guilds[1:]
+		s.mu.Unlock()
+
+		s.chunk(guild)
+	}
+}
+
+func (s *memberChunkingSchedulerImpl) chunk(guild scheduledGuild) {
+	var err error
+	for attempt := 1; attempt <= s.config.MaxRetries+1; attempt++ {
+		if attempt > 1 {
+			s.config.Logger.Debug("retrying member chunking", slog.Any("guild_id", guild.guildID), slog.Int("attempt", attempt), slog.Any("err", err))
+			select {
+			case <-s.ctx.Done():
+				s.finish(guild, GuildChunkingStatusFailed, s.ctx.Err())
+				return
+			case <-time.After(time.Duration(attempt-1) * s.config.RetryDelay):
+			}
+		}
+
+		s.update(guild.guildID, func(progress *GuildChunkingProgress) {
+			progress.Status = GuildChunkingStatusRequesting
+			progress.Members = 0
+			progress.Attempts = attempt
+		})
+
+		ctx, cancel := context.WithTimeout(s.ctx, s.config.Timeout)
+		err = s.request(ctx, guild.guildID, func() {
+			s.update(guild.guildID, func(progress *GuildChunkingProgress) {
+				progress.Members++
+			})
+		})
+		cancel()
+		if err == nil {
+			s.finish(guild, GuildChunkingStatusComplete, nil)
+			return
+		}
+		if s.ctx.Err() != nil {
+			break
+		}
+	}
+	s.config.Logger.Error("failed to chunk guild members", slog.Any("guild_id", guild.guildID), slog.Any("err", err))
+	s.finish(guild, GuildChunkingStatusFailed, err)
+}
+
+func (s *memberChunkingSchedulerImpl) update(guildID snowflake.ID, f func(progress *GuildChunkingProgress)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if progress, ok := s.progress[guildID]; ok {
+		f(progress)
+	}
+}
+
+func (s *memberChunkingSchedulerImpl) finish(guild scheduledGuild, status GuildChunkingStatus, err error) {
+	s.mu.Lock()
+	progress := s.progress[guild.guildID]
+	progress.Status = status
+	progress.Err = err
+	progress.CompletedAt = time.Now()
+	result := *progress
+	s.mu.Unlock()
+
+	if status == GuildChunkingStatusComplete && guild.onComplete != nil {
+		guild.onComplete(result)
+	}
+}
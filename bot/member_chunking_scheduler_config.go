@@ -0,0 +1,64 @@
+package bot
+
+import (
+	"log/slog"
+	"time"
+)
+
+func defaultMemberChunkingSchedulerConfig() memberChunkingSchedulerConfig {
+	return memberChunkingSchedulerConfig{
+		Logger:              slog.Default(),
+		ConcurrencyPerShard: 1,
+		Timeout:             time.Minute,
+		MaxRetries:          3,
+		RetryDelay:          5 * time.Second,
+	}
+}
+
+type memberChunkingSchedulerConfig struct {
+	Logger              *slog.Logger
+	ConcurrencyPerShard int
+	Timeout             time.Duration
+	MaxRetries          int
+	RetryDelay          time.Duration
+}
+
+// MemberChunkingSchedulerConfigOpt is a functional option for configuring a MemberChunkingScheduler.
+type MemberChunkingSchedulerConfigOpt func(config *memberChunkingSchedulerConfig)
+
+func (c *memberChunkingSchedulerConfig) apply(opts []MemberChunkingSchedulerConfigOpt) {
+	for _, opt := range opts {
+		opt(c)
+	}
+	c.Logger = c.Logger.With(slog.String("name", "bot_member_chunking_scheduler"))
+}
+
+// WithMemberChunkingSchedulerLogger overrides the default Logger in the memberChunkingSchedulerConfig.
+func WithMemberChunkingSchedulerLogger(logger *slog.Logger) MemberChunkingSchedulerConfigOpt {
+	return func(config *memberChunkingSchedulerConfig) {
+		config.Logger = logger
+	}
+}
+
+// WithChunkingConcurrencyPerShard sets how many guilds are chunked at the same time per shard. Defaults to 1.
+func WithChunkingConcurrencyPerShard(concurrency int) MemberChunkingSchedulerConfigOpt {
+	return func(config *memberChunkingSchedulerConfig) {
+		config.ConcurrencyPerShard = concurrency
+	}
+}
+
+// WithChunkingTimeout sets after which time.Duration a chunk request without all chunks is retried. Defaults to 1 minute.
+func WithChunkingTimeout(timeout time.Duration) MemberChunkingSchedulerConfigOpt {
+	return func(config *memberChunkingSchedulerConfig) {
+		config.Timeout = timeout
+	}
+}
+
+// WithChunkingRetries sets how often a failed or timed out chunk request is retried and the delay between the retries.
+// The delay is multiplied by the attempt. Defaults to 3 retries with 5 seconds delay.
+func WithChunkingRetries(maxRetries int, delay time.Duration) MemberChunkingSchedulerConfigOpt {
+	return func(config *memberChunkingSchedulerConfig) {
+		config.MaxRetries = maxRetries
+		config.RetryDelay = delay
+	}
+}
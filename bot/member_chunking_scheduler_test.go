@@ -0,0 +1,88 @@
+package bot
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemberChunkingScheduler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		running, maxRunning atomic.Int32
+		attempts            sync.Map
+		wg                  sync.WaitGroup
+	)
+	s := newMemberChunkingScheduler(func(ctx context.Context, guildID snowflake.ID, onMember func()) error {
+		n := running.Add(1)
+		defer running.Add(-1)
+		if n > maxRunning.Load() {
+			maxRunning.Store(n)
+		}
+
+		attempt, _ := attempts.LoadOrStore(guildID, new(atomic.Int32))
+		if attempt.(*atomic.Int32).Add(1) == 1 && guildID == 1 {
+			// simulate missing chunks
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		for range 3 {
+			onMember()
+		}
+		return nil
+	}, WithChunkingConcurrencyPerShard(2), WithChunkingTimeout(10*time.Millisecond), WithChunkingRetries(1, 0))
+	defer s.Close()
+
+	completed := make(chan GuildChunkingProgress, 6)
+	for guildID := range snowflake.ID(6) {
+		wg.Add(1)
+		s.Schedule(0, guildID+1, func(progress GuildChunkingProgress) {
+			defer wg.Done()
+			completed <- progress
+		})
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, maxRunning.Load(), int32(2))
+	assert.Zero(t, s.Pending())
+	progress, ok := s.Progress(1)
+	require.True(t, ok)
+	assert.Equal(t, GuildChunkingStatusComplete, progress.Status)
+	assert.Equal(t, 3, progress.Members)
+	assert.Equal(t, 2, progress.Attempts)
+}
+
+func TestMemberChunkingScheduler_Close(t *testing.T) {
+	t.Parallel()
+
+	requesting := make(chan struct{})
+	s := newMemberChunkingScheduler(func(ctx context.Context, guildID snowflake.ID, onMember func()) error {
+		close(requesting)
+		<-ctx.Done()
+		return ctx.Err()
+	}, WithChunkingConcurrencyPerShard(1), WithChunkingTimeout(time.Minute))
+
+	for guildID := range snowflake.ID(3) {
+		s.Schedule(0, guildID+1, nil)
+	}
+	<-requesting
+	assert.Equal(t, 3, s.Pending())
+
+	s.Close()
+	assert.Eventually(t, func() bool {
+		return s.Pending() == 0
+	}, time.Second, time.Millisecond)
+	for guildID := range snowflake.ID(3) {
+		progress, ok := s.Progress(guildID + 1)
+		require.True(t, ok)
+		assert.Equal(t, GuildChunkingStatusFailed, progress.Status)
+		assert.ErrorIs(t, progress.Err, context.Canceled)
+	}
+}
@@ -0,0 +1,311 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"slices"
+	"sync"
+
+	"github.com/disgoorg/snowflake/v2"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/rest"
+)
+
+var _ ModuleManager = (*moduleManagerImpl)(nil)
+
+var (
+	// ErrModuleDependencyCycle is returned when the dependencies of Module(s) form a cycle.
+	ErrModuleDependencyCycle = errors.New("module dependency cycle")
+	// ErrUnknownModule is returned when a Module depends on a Module which is not registered.
+	ErrUnknownModule = errors.New("unknown module")
+	// ErrModuleDisabled is returned when a Module is used in a guild it is disabled in.
+	ErrModuleDisabled = errors.New("module disabled")
+)
+
+// Module is a self-contained feature of a Client like a set of listeners, commands and background tasks.
+// Register modules with WithModules.
+type Module interface {
+	// Name returns the unique name of the Module.
+	Name() string
+	// Init is called in dependency order while the Client is built. All Module(s) it depends on are initialized before.
+	Init(client *Client) error
+	// Start is called in dependency order by ModuleManager.Start. Background tasks should be started here.
+	Start(ctx context.Context) error
+	// Stop is called in reverse dependency order when the Client is closed.
+	Stop(ctx context.Context) error
+}
+
+// ModuleDependencies is implemented by Module(s) which require other Module(s).
+type ModuleDependencies interface {
+	// Dependencies returns the names of the Module(s) which need to be initialized & started before this Module.
+	Dependencies() []string
+}
+
+// ModuleListeners is implemented by Module(s) which listen to events.
+// The EventListener(s) are added to the EventManager after the Module is initialized
+// and do not receive events of guilds the Module is disabled in.
+type ModuleListeners interface {
+	Listeners() []EventListener
+}
+
+// ModuleCommands is implemented by Module(s) which contribute application commands to ModuleManager.SyncCommands.
+type ModuleCommands interface {
+	Commands() []discord.ApplicationCommandCreate
+}
+
+// ModuleManager manages the lifecycle of the Module(s) of a Client and whether they are enabled per guild.
+type ModuleManager interface {
+	// Modules returns all Module(s) in dependency order.
+	Modules() []Module
+	// Module returns the Module with the given name.
+	Module(name string) (Module, bool)
+
+	// Start starts all Module(s) in dependency order. It stops the already started Module(s) if one fails to start.
+	Start(ctx context.Context) error
+	// Stop stops all started Module(s) in reverse dependency order.
+	Stop(ctx context.Context) error
+
+	// Commands returns the combined application commands of all Module(s) implementing ModuleCommands.
+	Commands() []discord.ApplicationCommandCreate
+	// SyncCommands sets the combined Commands for the given guilds or globally if no guildIDs are provided.
+	// The commands of a guild only include the commands of the Module(s) enabled in it.
+	SyncCommands(guildIDs []snowflake.ID, opts ...rest.RequestOpt) error
+
+	// Enable enables the Module in the given guild. Module(s) are enabled in all guilds by default.
+	Enable(name string, guildID snowflake.ID)
+	// Disable disables the Module in the given guild.
+	Disable(name string, guildID snowflake.ID)
+	// Enabled returns whether the Module is enabled in the given guild. It always returns true for guildID 0.
+	Enabled(name string, guildID snowflake.ID) bool
+}
+
+func newModuleManager(client *Client, logger *slog.Logger, modules []Module) (*moduleManagerImpl, error) {
+	sorted, err := sortModules(modules)
+	if err != nil {
+		return nil, err
+	}
+	return &moduleManagerImpl{
+		client:   client,
+		logger:   logger.With(slog.String("name", "bot_module_manager")),
+		modules:  sorted,
+		disabled: map[string]map[snowflake.ID]struct{}{},
+	}, nil
+}
+
+type moduleManagerImpl struct {
+	client  *Client
+	logger  *slog.Logger
+	modules []Module
+
+	startMu sync.Mutex
+	started int
+
+	mu       sync.RWMutex
+	disabled map[string]map[snowflake.ID]struct{}
+}
+
+// init initializes all Module(s) and adds their EventListener(s).
+func (m *moduleManagerImpl) init() error {
+	for _, module := range m.modules {
+		if err := module.Init(m.client); err != nil {
+			return fmt.Errorf("failed to init module %q: %w", module.Name(), err)
+		}
+		if ml, ok := module.(ModuleListeners); ok {
+			// wrap a copy, so the Module can keep using its EventListener(s) to remove them again
+			moduleListeners := ml.Listeners()
+			listeners := make([]EventListener, len(moduleListeners))
+			for i, listener := range moduleListeners {
+				listeners[i] = &moduleListener{manager: m, name: module.Name(), listener: listener}
+			}
+			m.client.EventManager.AddEventListeners(listeners...)
+		}
+		m.logger.Debug("initialized module", slog.String("module", module.Name()))
+	}
+	return nil
+}
+
+func (m *moduleManagerImpl) Modules() []Module {
+	return slices.Clone(m.modules)
+}
+
+func (m *moduleManagerImpl) Module(name string) (Module, bool) {
+	for _, module := range m.modules {
+		if module.Name() == name {
+			return module, true
+		}
+	}
+	return nil, false
+}
+
+func (m *moduleManagerImpl) Start(ctx context.Context) error {
+	m.startMu.Lock()
+	defer m.startMu.Unlock()
+	for m.started < len(m.modules) {
+		module := m.modules[m.started]
+		if err := module.Start(ctx); err != nil {
+			err = fmt.Errorf("failed to start module %q: %w", module.Name(), err)
+			return errors.Join(err, m.stop(ctx))
+		}
+		m.logger.Debug("started module", slog.String("module", module.Name()))
+		m.started++
+	}
+	return nil
+}
+
+func (m *moduleManagerImpl) Stop(ctx context.Context) error {
+	m.startMu.Lock()
+	defer m.startMu.Unlock()
+	return m.stop(ctx)
+}
+
+func (m *moduleManagerImpl) stop(ctx context.Context) error {
+	var errs []error
+	for ; m.started > 0; m.started-- {
+		module := m.modules[m.started-1]
+		if err := module.Stop(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("failed to stop module %q: %w", module.Name(), err))
+			continue
+		}
+		m.logger.Debug("stopped module", slog.String("module", module.Name()))
+	}
+	return errors.Join(errs...)
+}
+
+func (m *moduleManagerImpl) Commands() []discord.ApplicationCommandCreate {
+	return m.commands(0)
+}
+
+// commands returns the combined application commands of all Module(s) enabled in the given guild.
+func (m *moduleManagerImpl) commands(guildID snowflake.ID) []discord.ApplicationCommandCreate {
+	var commands []discord.ApplicationCommandCreate
+	for _, module := range m.modules {
+		if mc, ok := module.(ModuleCommands); ok && m.Enabled(module.Name(), guildID) {
+			commands = append(commands, mc.Commands()...)
+		}
+	}
+	return commands
+}
+
+func (m *moduleManagerImpl) SyncCommands(guildIDs []snowflake.ID, opts ...rest.RequestOpt) error {
+	if len(guildIDs) == 0 {
+		_, err := m.client.Rest.SetGlobalCommands(m.client.ApplicationID, m.Commands(), opts...)
+		return err
+	}
+	for _, guildID := range guildIDs {
+		if _, err := m.client.Rest.SetGuildCommands(m.client.ApplicationID, guildID, m.commands(guildID), opts...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *moduleManagerImpl) Enable(name string, guildID snowflake.ID) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.disabled[name], guildID)
+}
+
+func (m *moduleManagerImpl) Disable(name string, guildID snowflake.ID) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	guilds, ok := m.disabled[name]
+	if !ok {
+		guilds = map[snowflake.ID]struct{}{}
+		m.disabled[name] = guilds
+	}
+	guilds[guildID] = struct{}{}
+}
+
+func (m *moduleManagerImpl) Enabled(name string, guildID snowflake.ID) bool {
+	if guildID == 0 {
+		return true
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	_, disabled := m.disabled[name][guildID]
+	return !disabled
+}
+
+// moduleListener only passes events to the EventListener of a Module if the Module is enabled in the guild of the event.
+type moduleListener struct {
+	manager  ModuleManager
+	name     string
+	listener EventListener
+}
+
+func (l *moduleListener) eventType() reflect.Type {
+	if listener, ok := l.listener.(typedEventListener); ok {
+		return listener.eventType()
+	}
+	return nil
+}
+
+func (l *moduleListener) OnEvent(event Event) {
+	_ = l.OnEventErr(context.Background(), event)
+}
+
+func (l *moduleListener) OnEventErr(ctx context.Context, event Event) error {
+	if !l.manager.Enabled(l.name, eventGuildID(event)) {
+		return nil
+	}
+	if listener, ok := l.listener.(EventListenerErr); ok {
+		return listener.OnEventErr(ctx, event)
+	}
+	l.listener.OnEvent(event)
+	return nil
+}
+
+// sortModules sorts the Module(s) so every Module comes after its dependencies while keeping the registration order otherwise.
+func sortModules(modules []Module) ([]Module, error) {
+	byName := make(map[string]Module, len(modules))
+	for _, module := range modules {
+		if _, ok := byName[module.Name()]; ok {
+			return nil, fmt.Errorf("duplicate module %q", module.Name())
+		}
+		byName[module.Name()] = module
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(modules))
+	sorted := make([]Module, 0, len(modules))
+
+	var visit func(module Module) error
+	visit = func(module Module) error {
+		switch state[module.Name()] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("%w: %q", ErrModuleDependencyCycle, module.Name())
+		}
+		state[module.Name()] = visiting
+		if md, ok := module.(ModuleDependencies); ok {
+			for _, name := range md.Dependencies() {
+				dependency, ok := byName[name]
+				if !ok {
+					return fmt.Errorf("%w: %q required by %q", ErrUnknownModule, name, module.Name())
+				}
+				if err := visit(dependency); err != nil {
+					return err
+				}
+			}
+		}
+		state[module.Name()] = visited
+		sorted = append(sorted, module)
+		return nil
+	}
+
+	for _, module := range modules {
+		if err := visit(module); err != nil {
+			return nil, err
+		}
+	}
+	return sorted, nil
+}
@@ -0,0 +1,149 @@
+package bot
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/rest"
+)
+
+type testModule struct {
+	name         string
+	dependencies []string
+	calls        *[]string
+	events       []int
+}
+
+func (m *testModule) Name() string           { return m.name }
+func (m *testModule) Dependencies() []string { return m.dependencies }
+
+func (m *testModule) Init(_ *Client) error {
+	*m.calls = append(*m.calls, "init "+m.name)
+	return nil
+}
+
+func (m *testModule) Start(_ context.Context) error {
+	*m.calls = append(*m.calls, "start "+m.name)
+	return nil
+}
+
+func (m *testModule) Stop(_ context.Context) error {
+	*m.calls = append(*m.calls, "stop "+m.name)
+	return nil
+}
+
+func (m *testModule) Listeners() []EventListener {
+	return []EventListener{NewListenerFunc(func(e *testEvent) {
+		m.events = append(m.events, e.n)
+	})}
+}
+
+func TestModuleManager_Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	var calls []string
+	a := &testModule{name: "a", dependencies: []string{"b"}, calls: &calls}
+	b := &testModule{name: "b", calls: &calls}
+
+	client := newTestClient()
+	m, err := newModuleManager(client, slog.Default(), []Module{a, b})
+	require.NoError(t, err)
+	require.NoError(t, m.init())
+	require.NoError(t, m.Start(context.Background()))
+	require.NoError(t, m.Stop(context.Background()))
+	assert.Equal(t, []string{"init b", "init a", "start b", "start a", "stop a", "stop b"}, calls)
+
+	guildID := snowflake.ID(1)
+	m.Disable("a", guildID)
+	client.EventManager.DispatchEvent(&testEvent{GuildID: &guildID, n: 1})
+	m.Enable("a", guildID)
+	client.EventManager.DispatchEvent(&testEvent{GuildID: &guildID, n: 2})
+	assert.Equal(t, []int{2}, a.events)
+	assert.Equal(t, []int{1, 2}, b.events)
+}
+
+func TestModuleManager_Dependencies(t *testing.T) {
+	t.Parallel()
+
+	var calls []string
+	_, err := newModuleManager(nil, slog.Default(), []Module{
+		&testModule{name: "a", dependencies: []string{"b"}, calls: &calls},
+		&testModule{name: "b", dependencies: []string{"a"}, calls: &calls},
+	})
+	assert.ErrorIs(t, err, ErrModuleDependencyCycle)
+
+	_, err = newModuleManager(nil, slog.Default(), []Module{
+		&testModule{name: "a", dependencies: []string{"c"}, calls: &calls},
+	})
+	assert.ErrorIs(t, err, ErrUnknownModule)
+}
+
+type commandModule struct {
+	testModule
+	commands  []discord.ApplicationCommandCreate
+	listeners []EventListener
+}
+
+func (m *commandModule) Commands() []discord.ApplicationCommandCreate { return m.commands }
+func (m *commandModule) Listeners() []EventListener                   { return m.listeners }
+
+type syncCommandsRest struct {
+	rest.Rest
+	global []string
+	guilds map[snowflake.ID][]string
+}
+
+func commandNames(commands []discord.ApplicationCommandCreate) []string {
+	names := make([]string, len(commands))
+	for i, command := range commands {
+		names[i] = command.CommandName()
+	}
+	return names
+}
+
+func (r *syncCommandsRest) SetGlobalCommands(_ snowflake.ID, commands []discord.ApplicationCommandCreate, _ ...rest.RequestOpt) ([]discord.ApplicationCommand, error) {
+	r.global = commandNames(commands)
+	return nil, nil
+}
+
+func (r *syncCommandsRest) SetGuildCommands(_ snowflake.ID, guildID snowflake.ID, commands []discord.ApplicationCommandCreate, _ ...rest.RequestOpt) ([]discord.ApplicationCommand, error) {
+	r.guilds[guildID] = commandNames(commands)
+	return nil, nil
+}
+
+func TestModuleManager_SyncCommands(t *testing.T) {
+	t.Parallel()
+
+	var calls []string
+	listener := NewListenerFunc(func(e *testEvent) {})
+	a := &commandModule{
+		testModule: testModule{name: "a", calls: &calls},
+		commands:   []discord.ApplicationCommandCreate{discord.SlashCommandCreate{Name: "a"}},
+		listeners:  []EventListener{listener},
+	}
+	b := &commandModule{
+		testModule: testModule{name: "b", calls: &calls},
+		commands:   []discord.ApplicationCommandCreate{discord.SlashCommandCreate{Name: "b"}},
+	}
+
+	client := newTestClient()
+	r := &syncCommandsRest{guilds: map[snowflake.ID][]string{}}
+	client.Rest = r
+	m, err := newModuleManager(client, slog.Default(), []Module{a, b})
+	require.NoError(t, err)
+	require.NoError(t, m.init())
+	// the EventListener(s) returned by the Module must not be replaced by their wrappers
+	assert.Equal(t, listener, a.listeners[0])
+
+	m.Disable("a", 1)
+	require.NoError(t, m.SyncCommands(nil))
+	require.NoError(t, m.SyncCommands([]snowflake.ID{1, 2}))
+	assert.Equal(t, []string{"a", "b"}, r.global)
+	assert.Equal(t, map[snowflake.ID][]string{1: {"b"}, 2: {"a", "b"}}, r.guilds)
+}
@@ -0,0 +1,471 @@
+// Package botconfig provides a declarative config for a bot.Client which can be loaded from YAML, TOML or JSON files
+// with environment variable overrides and is converted to the equivalent bot.ConfigOpt(s).
+package botconfig
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/disgoorg/json/v2"
+	"gopkg.in/yaml.v3"
+
+	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/disgo/cache"
+	"github.com/disgoorg/disgo/gateway"
+	"github.com/disgoorg/disgo/httpserver"
+	"github.com/disgoorg/disgo/internal/tokenhelper"
+	"github.com/disgoorg/disgo/rest"
+	"github.com/disgoorg/disgo/sharding"
+)
+
+var (
+	// ErrUnknownFormat is returned by FormatFromPath when the file extension is not supported
+	ErrUnknownFormat = errors.New("unknown config format")
+
+	// ErrInvalidConfig is returned when a Config fails validation
+	ErrInvalidConfig = errors.New("invalid config")
+)
+
+// Format is the file format of a Config
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatYAML Format = "yaml"
+	FormatTOML Format = "toml"
+)
+
+// FormatFromPath returns the Format for the file extension of the path
+func FormatFromPath(path string) (Format, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return FormatJSON, nil
+	case ".yaml", ".yml":
+		return FormatYAML, nil
+	case ".toml":
+		return FormatTOML, nil
+	}
+	return "", fmt.Errorf("%w: %s", ErrUnknownFormat, path)
+}
+
+// Load reads the config file at the given path, applies environment variable overrides and validates the Config.
+// The Format is detected by the file extension.
+func Load(path string, opts ...LoadOpt) (*Config, error) {
+	format, err := FormatFromPath(path)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+	return Parse(data, format, opts...)
+}
+
+// LoadEnv creates a Config only from environment variables and validates it
+func LoadEnv(opts ...LoadOpt) (*Config, error) {
+	return Parse(nil, FormatJSON, opts...)
+}
+
+// Parse decodes the config in the given Format, applies environment variable overrides and validates the Config.
+// Unknown keys are rejected to catch typos.
+//
+// Environment variables are named after the uppercased keys joined by '_' with the env prefix like DISGO_TOKEN
+// or DISGO_REST_RATE_LIMITER_MAX_RETRIES. Lists are comma separated and maps are comma separated key=value pairs.
+func Parse(data []byte, format Format, opts ...LoadOpt) (*Config, error) {
+	cfg := defaultLoadConfig()
+	cfg.apply(opts)
+
+	var config Config
+	if err := decode(data, format, &config); err != nil {
+		return nil, fmt.Errorf("failed to decode config: %w", err)
+	}
+	if cfg.LookupEnv != nil {
+		if _, err := applyEnv(reflect.ValueOf(&config).Elem(), cfg.EnvPrefix, cfg.LookupEnv); err != nil {
+			return nil, err
+		}
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+func decode(data []byte, format Format, v any) error {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil
+	}
+
+	switch format {
+	case FormatJSON:
+	case FormatYAML:
+		var m map[string]any
+		if err := yaml.Unmarshal(data, &m); err != nil {
+			return err
+		}
+		var err error
+		if data, err = json.Marshal(m); err != nil {
+			return err
+		}
+	case FormatTOML:
+		var m map[string]any
+		if err := toml.Unmarshal(data, &m); err != nil {
+			return err
+		}
+		var err error
+		if data, err = json.Marshal(m); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownFormat, format)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(v)
+}
+
+// Config is the declarative config of a bot.Client
+type Config struct {
+	// Token is the bot token. Prefer setting it with the DISGO_TOKEN environment variable.
+	Token  string       `json:"token"`
+	Logger LoggerConfig `json:"logger"`
+	// Gateway configures the gateway.Gateway. If Sharding is set, it configures the gateway.Gateway of every shard instead.
+	Gateway *GatewayConfig `json:"gateway"`
+	// Sharding configures a sharding.ShardManager which uses the gateway options of Gateway for all shards.
+	Sharding *ShardingConfig `json:"sharding"`
+	Cache    CacheConfig     `json:"cache"`
+	Rest     RestConfig      `json:"rest"`
+	// HTTPServer configures a httpserver.Server to receive interactions via HTTP.
+	HTTPServer *HTTPServerConfig `json:"http_server"`
+}
+
+// LoggerConfig configures the slog.Logger of the bot.Client
+type LoggerConfig struct {
+	// Level is the minimum level like "debug", "info", "warn" or "error". Defaults to "info".
+	Level string `json:"level"`
+	// Format is the output format "text" or "json". Defaults to "text".
+	Format string `json:"format"`
+}
+
+// NewLogger returns a new slog.Logger writing to os.Stderr
+func (c LoggerConfig) NewLogger() *slog.Logger {
+	var level slog.Level
+	if c.Level != "" {
+		_ = level.UnmarshalText([]byte(c.Level))
+	}
+	opts := &slog.HandlerOptions{Level: level}
+	if strings.EqualFold(c.Format, "json") {
+		return slog.New(slog.NewJSONHandler(os.Stderr, opts))
+	}
+	return slog.New(slog.NewTextHandler(os.Stderr, opts))
+}
+
+// GatewayConfig configures the gateway.Gateway
+type GatewayConfig struct {
+	// Intents are the names of the gateway.Intents like "guilds", "guild_messages" or "non_privileged"
+	Intents        []string `json:"intents"`
+	Compress       *bool    `json:"compress"`
+	LargeThreshold int      `json:"large_threshold"`
+}
+
+// ShardingConfig configures the sharding.ShardManager
+type ShardingConfig struct {
+	ShardIDs        []int `json:"shard_ids"`
+	ShardCount      int   `json:"shard_count"`
+	AutoScaling     bool  `json:"auto_scaling"`
+	ShardSplitCount int   `json:"shard_split_count"`
+}
+
+// CacheConfig configures the cache.Caches
+type CacheConfig struct {
+	// Flags are the names of the cache.Flags like "guilds", "members" or "all"
+	Flags []string `json:"flags"`
+	// Policies maps a cache name like "members" or "messages" to the policy "all" or "none".
+	// Members additionally support the policy "pending".
+	Policies map[string]string `json:"policies"`
+}
+
+// RestConfig configures the rest.Client
+type RestConfig struct {
+	URL         string            `json:"url"`
+	UserAgent   string            `json:"user_agent"`
+	Timeout     Duration          `json:"timeout"`
+	RateLimiter RateLimiterConfig `json:"rate_limiter"`
+}
+
+// RateLimiterConfig configures the rest.RateLimiter
+type RateLimiterConfig struct {
+	MaxRetries            *int     `json:"max_retries"`
+	CleanupInterval       Duration `json:"cleanup_interval"`
+	PriorityAgingInterval Duration `json:"priority_aging_interval"`
+}
+
+// HTTPServerConfig configures the httpserver.Server
+type HTTPServerConfig struct {
+	// PublicKey is the hex encoded public key of the application
+	PublicKey string `json:"public_key"`
+	Address   string `json:"address"`
+	URL       string `json:"url"`
+	CertFile  string `json:"cert_file"`
+	KeyFile   string `json:"key_file"`
+}
+
+// Duration is a time.Duration which is encoded as a string like "30s" or "1m30s"
+type Duration time.Duration
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("duration must be a string like \"30s\": %w", err)
+	}
+	duration, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(duration)
+	return nil
+}
+
+// Validate checks the Config for missing or invalid values and returns all problems wrapped in ErrInvalidConfig
+func (c *Config) Validate() error {
+	var errs []error
+	addErr := func(key string, format string, a ...any) {
+		errs = append(errs, fmt.Errorf("%s: %s", key, fmt.Sprintf(format, a...)))
+	}
+
+	if c.Token == "" {
+		addErr("token", "is required")
+	} else if _, err := tokenhelper.IDFromToken(c.Token); err != nil {
+		addErr("token", "invalid bot token")
+	}
+
+	if c.Logger.Level != "" {
+		var level slog.Level
+		if err := level.UnmarshalText([]byte(c.Logger.Level)); err != nil {
+			addErr("logger.level", "unknown level %q", c.Logger.Level)
+		}
+	}
+	if c.Logger.Format != "" && !strings.EqualFold(c.Logger.Format, "text") && !strings.EqualFold(c.Logger.Format, "json") {
+		addErr("logger.format", "unknown format %q", c.Logger.Format)
+	}
+
+	if c.Gateway != nil {
+		for _, name := range c.Gateway.Intents {
+			if _, ok := intentNames[normalizeName(name)]; !ok {
+				addErr("gateway.intents", "unknown intent %q", name)
+			}
+		}
+		if c.Gateway.LargeThreshold != 0 && (c.Gateway.LargeThreshold < 50 || c.Gateway.LargeThreshold > 250) {
+			addErr("gateway.large_threshold", "must be between 50 and 250")
+		}
+	}
+
+	if c.Sharding != nil {
+		if c.Sharding.ShardCount < 0 {
+			addErr("sharding.shard_count", "must not be negative")
+		}
+		if c.Sharding.ShardSplitCount < 0 {
+			addErr("sharding.shard_split_count", "must not be negative")
+		}
+		for i, shardID := range c.Sharding.ShardIDs {
+			if shardID < 0 || c.Sharding.ShardCount > 0 && shardID >= c.Sharding.ShardCount {
+				addErr("sharding.shard_ids", "shard id %d is out of range", shardID)
+			}
+			if slices.Contains(c.Sharding.ShardIDs[:i], shardID) {
+				addErr("sharding.shard_ids", "duplicate shard id %d", shardID)
+			}
+		}
+	}
+
+	for _, name := range c.Cache.Flags {
+		if _, ok := cacheFlagNames[normalizeName(name)]; !ok {
+			addErr("cache.flags", "unknown cache flag %q", name)
+		}
+	}
+	for name, policy := range c.Cache.Policies {
+		cachePolicy, ok := cachePolicies[normalizeName(name)]
+		if !ok {
+			addErr("cache.policies", "unknown cache %q", name)
+			continue
+		}
+		if _, ok = cachePolicy(normalizeName(policy)); !ok {
+			addErr("cache.policies."+name, "unknown policy %q", policy)
+		}
+	}
+
+	if c.Rest.URL != "" {
+		if u, err := url.Parse(c.Rest.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			addErr("rest.url", "must be an absolute http(s) url")
+		}
+	}
+	if c.Rest.Timeout < 0 {
+		addErr("rest.timeout", "must not be negative")
+	}
+	if c.Rest.RateLimiter.MaxRetries != nil && *c.Rest.RateLimiter.MaxRetries < 0 {
+		addErr("rest.rate_limiter.max_retries", "must not be negative")
+	}
+	if c.Rest.RateLimiter.CleanupInterval < 0 {
+		addErr("rest.rate_limiter.cleanup_interval", "must not be negative")
+	}
+	if c.Rest.RateLimiter.PriorityAgingInterval < 0 {
+		addErr("rest.rate_limiter.priority_aging_interval", "must not be negative")
+	}
+
+	if c.HTTPServer != nil {
+		if c.HTTPServer.PublicKey == "" {
+			addErr("http_server.public_key", "is required")
+		} else if key, err := hex.DecodeString(c.HTTPServer.PublicKey); err != nil || len(key) != ed25519.PublicKeySize {
+			addErr("http_server.public_key", "must be a hex encoded ed25519 public key")
+		}
+		if c.HTTPServer.URL != "" && !strings.HasPrefix(c.HTTPServer.URL, "/") {
+			addErr("http_server.url", "must start with '/'")
+		}
+		if (c.HTTPServer.CertFile == "") != (c.HTTPServer.KeyFile == "") {
+			addErr("http_server", "cert_file and key_file must be set together")
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %w", ErrInvalidConfig, errors.Join(errs...))
+	}
+	return nil
+}
+
+// ConfigOpts validates the Config and returns the equivalent bot.ConfigOpt(s).
+// Pass them together with the Token to disgo.New.
+func (c *Config) ConfigOpts() ([]bot.ConfigOpt, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	var opts []bot.ConfigOpt
+	if c.Logger.Level != "" || c.Logger.Format != "" {
+		opts = append(opts, bot.WithLogger(c.Logger.NewLogger()))
+	}
+
+	gatewayOpts := c.gatewayConfigOpts()
+	if c.Sharding != nil {
+		shardingOpts := []sharding.ConfigOpt{sharding.WithDefault()}
+		if len(c.Sharding.ShardIDs) > 0 {
+			shardingOpts = append(shardingOpts, sharding.WithShardIDs(c.Sharding.ShardIDs...))
+		}
+		if c.Sharding.ShardCount > 0 {
+			shardingOpts = append(shardingOpts, sharding.WithShardCount(c.Sharding.ShardCount))
+		}
+		if c.Sharding.AutoScaling {
+			shardingOpts = append(shardingOpts, sharding.WithAutoScaling(true))
+		}
+		if c.Sharding.ShardSplitCount > 0 {
+			shardingOpts = append(shardingOpts, sharding.WithShardSplitCount(c.Sharding.ShardSplitCount))
+		}
+		if len(gatewayOpts) > 0 {
+			shardingOpts = append(shardingOpts, sharding.WithGatewayConfigOpts(gatewayOpts...))
+		}
+		opts = append(opts, bot.WithShardManagerConfigOpts(shardingOpts...))
+	} else if c.Gateway != nil {
+		opts = append(opts, bot.WithDefaultGateway(), bot.WithGatewayConfigOpts(gatewayOpts...))
+	}
+
+	var cacheOpts []cache.ConfigOpt
+	if len(c.Cache.Flags) > 0 {
+		flags := make([]cache.Flags, 0, len(c.Cache.Flags))
+		for _, name := range c.Cache.Flags {
+			flags = append(flags, cacheFlagNames[normalizeName(name)])
+		}
+		cacheOpts = append(cacheOpts, cache.WithCaches(flags...))
+	}
+	for name, policy := range c.Cache.Policies {
+		opt, _ := cachePolicies[normalizeName(name)](normalizeName(policy))
+		cacheOpts = append(cacheOpts, opt)
+	}
+	if len(cacheOpts) > 0 {
+		opts = append(opts, bot.WithCacheConfigOpts(cacheOpts...))
+	}
+
+	if restOpts := c.restConfigOpts(); len(restOpts) > 0 {
+		opts = append(opts, bot.WithRestClientConfigOpts(restOpts...))
+	}
+
+	if c.HTTPServer != nil {
+		var httpServerOpts []httpserver.ConfigOpt
+		if c.HTTPServer.Address != "" {
+			httpServerOpts = append(httpServerOpts, httpserver.WithAddress(c.HTTPServer.Address))
+		}
+		if c.HTTPServer.URL != "" {
+			httpServerOpts = append(httpServerOpts, httpserver.WithURL(c.HTTPServer.URL))
+		}
+		if c.HTTPServer.CertFile != "" {
+			httpServerOpts = append(httpServerOpts, httpserver.WithTLS(c.HTTPServer.CertFile, c.HTTPServer.KeyFile))
+		}
+		opts = append(opts, bot.WithHTTPServerConfigOpts(c.HTTPServer.PublicKey, httpServerOpts...))
+	}
+
+	return opts, nil
+}
+
+func (c *Config) gatewayConfigOpts() []gateway.ConfigOpt {
+	if c.Gateway == nil {
+		return nil
+	}
+	var opts []gateway.ConfigOpt
+	if len(c.Gateway.Intents) > 0 {
+		intents := make([]gateway.Intents, 0, len(c.Gateway.Intents))
+		for _, name := range c.Gateway.Intents {
+			intents = append(intents, intentNames[normalizeName(name)])
+		}
+		opts = append(opts, gateway.WithIntents(intents...))
+	}
+	if c.Gateway.Compress != nil {
+		opts = append(opts, gateway.WithCompress(*c.Gateway.Compress))
+	}
+	if c.Gateway.LargeThreshold > 0 {
+		opts = append(opts, gateway.WithLargeThreshold(c.Gateway.LargeThreshold))
+	}
+	return opts
+}
+
+func (c *Config) restConfigOpts() []rest.ConfigOpt {
+	var opts []rest.ConfigOpt
+	if c.Rest.URL != "" {
+		opts = append(opts, rest.WithURL(c.Rest.URL))
+	}
+	if c.Rest.UserAgent != "" {
+		opts = append(opts, rest.WithUserAgent(c.Rest.UserAgent))
+	}
+	if c.Rest.Timeout > 0 {
+		opts = append(opts, rest.WithHTTPClient(&http.Client{Timeout: time.Duration(c.Rest.Timeout)}))
+	}
+
+	var rateLimiterOpts []rest.RateLimiterConfigOpt
+	if c.Rest.RateLimiter.MaxRetries != nil {
+		rateLimiterOpts = append(rateLimiterOpts, rest.WithMaxRetries(*c.Rest.RateLimiter.MaxRetries))
+	}
+	if c.Rest.RateLimiter.CleanupInterval > 0 {
+		rateLimiterOpts = append(rateLimiterOpts, rest.WithCleanupInterval(time.Duration(c.Rest.RateLimiter.CleanupInterval)))
+	}
+	if c.Rest.RateLimiter.PriorityAgingInterval > 0 {
+		rateLimiterOpts = append(rateLimiterOpts, rest.WithPriorityAgingInterval(time.Duration(c.Rest.RateLimiter.PriorityAgingInterval)))
+	}
+	if len(rateLimiterOpts) > 0 {
+		opts = append(opts, rest.WithRateLimiterConfigOpts(rateLimiterOpts...))
+	}
+	return opts
+}
@@ -0,0 +1,44 @@
+package botconfig
+
+import (
+	"os"
+)
+
+// DefaultEnvPrefix is the default prefix of environment variables which override config values
+const DefaultEnvPrefix = "DISGO"
+
+func defaultLoadConfig() loadConfig {
+	return loadConfig{
+		EnvPrefix: DefaultEnvPrefix,
+		LookupEnv: os.LookupEnv,
+	}
+}
+
+type loadConfig struct {
+	EnvPrefix string
+	LookupEnv func(key string) (string, bool)
+}
+
+// LoadOpt is used to provide optional parameters to Load, LoadEnv & Parse
+type LoadOpt func(config *loadConfig)
+
+func (c *loadConfig) apply(opts []LoadOpt) {
+	for _, opt := range opts {
+		opt(c)
+	}
+}
+
+// WithEnvPrefix sets the prefix of environment variables which override config values. Defaults to DefaultEnvPrefix.
+func WithEnvPrefix(prefix string) LoadOpt {
+	return func(config *loadConfig) {
+		config.EnvPrefix = prefix
+	}
+}
+
+// WithLookupEnv sets the function used to look up environment variables. Defaults to os.LookupEnv.
+// Passing nil disables environment variable overrides.
+func WithLookupEnv(lookupEnv func(key string) (string, bool)) LoadOpt {
+	return func(config *loadConfig) {
+		config.LookupEnv = lookupEnv
+	}
+}
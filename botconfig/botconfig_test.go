@@ -0,0 +1,160 @@
+package botconfig
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testToken     = base64.RawStdEncoding.EncodeToString([]byte("123456789012345678")) + ".abc.def"
+	testPublicKey = "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+)
+
+func noEnv(string) (string, bool) {
+	return "", false
+}
+
+func TestParse_Formats(t *testing.T) {
+	t.Parallel()
+
+	configs := map[Format]string{
+		FormatJSON: `{
+	"token": "` + testToken + `",
+	"logger": {"level": "debug"},
+	"gateway": {"intents": ["guilds", "guild_messages"], "compress": true},
+	"sharding": {"shard_ids": [0, 1], "shard_count": 4},
+	"cache": {"flags": ["guilds", "members"], "policies": {"members": "pending"}},
+	"rest": {"url": "https://example.com/api/v10", "rate_limiter": {"max_retries": 3, "cleanup_interval": "30s"}},
+	"http_server": {"address": ":8080", "public_key": "` + testPublicKey + `"}
+}`,
+		FormatYAML: `
+token: ` + testToken + `
+logger:
+  level: debug
+gateway:
+  intents: [guilds, guild_messages]
+  compress: true
+sharding:
+  shard_ids: [0, 1]
+  shard_count: 4
+cache:
+  flags: [guilds, members]
+  policies:
+    members: pending
+rest:
+  url: https://example.com/api/v10
+  rate_limiter:
+    max_retries: 3
+    cleanup_interval: 30s
+http_server:
+  address: ":8080"
+  public_key: "` + testPublicKey + `"
+`,
+		FormatTOML: `
+token = "` + testToken + `"
+logger.level = "debug"
+
+[gateway]
+intents = ["guilds", "guild_messages"]
+compress = true
+
+[sharding]
+shard_ids = [0, 1]
+shard_count = 4
+
+[cache]
+flags = ["guilds", "members"]
+policies = { members = "pending" }
+
+[rest]
+url = "https://example.com/api/v10"
+
+[rest.rate_limiter]
+max_retries = 3
+cleanup_interval = "30s"
+
+[http_server]
+address = ":8080"
+public_key = "` + testPublicKey + `"
+`,
+	}
+
+	compress := true
+	maxRetries := 3
+	expected := &Config{
+		Token:    testToken,
+		Logger:   LoggerConfig{Level: "debug"},
+		Gateway:  &GatewayConfig{Intents: []string{"guilds", "guild_messages"}, Compress: &compress},
+		Sharding: &ShardingConfig{ShardIDs: []int{0, 1}, ShardCount: 4},
+		Cache:    CacheConfig{Flags: []string{"guilds", "members"}, Policies: map[string]string{"members": "pending"}},
+		Rest: RestConfig{
+			URL:         "https://example.com/api/v10",
+			RateLimiter: RateLimiterConfig{MaxRetries: &maxRetries, CleanupInterval: Duration(30 * time.Second)},
+		},
+		HTTPServer: &HTTPServerConfig{Address: ":8080", PublicKey: testPublicKey},
+	}
+
+	for format, data := range configs {
+		config, err := Parse([]byte(data), format, WithLookupEnv(noEnv))
+		require.NoError(t, err, format)
+		assert.Equal(t, expected, config, format)
+
+		opts, err := config.ConfigOpts()
+		require.NoError(t, err, format)
+		assert.Len(t, opts, 5, format)
+	}
+}
+
+func TestParse_EnvOverrides(t *testing.T) {
+	t.Parallel()
+
+	env := map[string]string{
+		"BOT_TOKEN":                         testToken,
+		"BOT_GATEWAY_INTENTS":               "guilds, message_content",
+		"BOT_REST_RATE_LIMITER_MAX_RETRIES": "5",
+		"BOT_REST_TIMEOUT":                  "10s",
+		"BOT_CACHE_POLICIES":                "messages=none,members=all",
+	}
+	config, err := Parse([]byte(`{"token": "invalid", "rest": {"url": "https://example.com"}}`), FormatJSON,
+		WithEnvPrefix("BOT"),
+		WithLookupEnv(func(key string) (string, bool) {
+			v, ok := env[key]
+			return v, ok
+		}),
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, testToken, config.Token)
+	require.NotNil(t, config.Gateway)
+	assert.Equal(t, []string{"guilds", "message_content"}, config.Gateway.Intents)
+	assert.Nil(t, config.Sharding)
+	assert.Nil(t, config.HTTPServer)
+	assert.Equal(t, "https://example.com", config.Rest.URL)
+	assert.Equal(t, 5, *config.Rest.RateLimiter.MaxRetries)
+	assert.Equal(t, Duration(10*time.Second), config.Rest.Timeout)
+	assert.Equal(t, map[string]string{"messages": "none", "members": "all"}, config.Cache.Policies)
+}
+
+func TestParse_Invalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := Parse([]byte(`{"token": "`+testToken+`", "unknown": true}`), FormatJSON, WithLookupEnv(noEnv))
+	assert.Error(t, err)
+
+	_, err = Parse([]byte(`
+token: invalid
+logger: {level: verbose}
+gateway: {intents: [guilds, typo]}
+sharding: {shard_ids: [0, 4, 0], shard_count: 4}
+cache: {policies: {members: pending, messages: pending}}
+http_server: {public_key: abc}
+`), FormatYAML, WithLookupEnv(noEnv))
+	require.ErrorIs(t, err, ErrInvalidConfig)
+	for _, key := range []string{"token", "logger.level", "gateway.intents", "shard id 4 is out of range", "duplicate shard id 0", "cache.policies.messages", "http_server.public_key"} {
+		assert.ErrorContains(t, err, key)
+	}
+}
@@ -0,0 +1,128 @@
+package botconfig
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var durationType = reflect.TypeOf(Duration(0))
+
+// applyEnv overrides the fields of the struct v with the environment variables named after the json tags of the fields,
+// like DISGO_REST_RATE_LIMITER_MAX_RETRIES. Nil struct pointers are only allocated if at least one of their fields is set.
+func applyEnv(v reflect.Value, prefix string, lookupEnv func(key string) (string, bool)) (bool, error) {
+	var set bool
+	for i := range v.NumField() {
+		field := v.Field(i)
+		name, _, _ := strings.Cut(v.Type().Field(i).Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		key := strings.ToUpper(name)
+		if prefix != "" {
+			key = prefix + "_" + key
+		}
+
+		switch {
+		case field.Kind() == reflect.Struct:
+			ok, err := applyEnv(field, key, lookupEnv)
+			if err != nil {
+				return false, err
+			}
+			set = set || ok
+		case field.Kind() == reflect.Pointer && field.Type().Elem().Kind() == reflect.Struct:
+			value := reflect.New(field.Type().Elem())
+			if !field.IsNil() {
+				value = field
+			}
+			ok, err := applyEnv(value.Elem(), key, lookupEnv)
+			if err != nil {
+				return false, err
+			}
+			if ok {
+				field.Set(value)
+				set = true
+			}
+		default:
+			env, ok := lookupEnv(key)
+			if !ok {
+				continue
+			}
+			if err := setEnvValue(field, env); err != nil {
+				return false, fmt.Errorf("environment variable %s: %w", key, err)
+			}
+			set = true
+		}
+	}
+	return set, nil
+}
+
+// setEnvValue parses the environment variable value into the field.
+// Lists are comma separated and maps are comma separated key=value pairs.
+func setEnvValue(field reflect.Value, env string) error {
+	if field.Type() == durationType {
+		d, err := time.ParseDuration(env)
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.ValueOf(Duration(d)))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(env)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(env)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case reflect.Int:
+		i, err := strconv.Atoi(env)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(i))
+	case reflect.Pointer:
+		value := reflect.New(field.Type().Elem())
+		if err := setEnvValue(value.Elem(), env); err != nil {
+			return err
+		}
+		field.Set(value)
+	case reflect.Slice:
+		parts := splitList(env)
+		slice := reflect.MakeSlice(field.Type(), len(parts), len(parts))
+		for i, part := range parts {
+			if err := setEnvValue(slice.Index(i), part); err != nil {
+				return err
+			}
+		}
+		field.Set(slice)
+	case reflect.Map:
+		m := reflect.MakeMap(field.Type())
+		for _, part := range splitList(env) {
+			k, v, ok := strings.Cut(part, "=")
+			if !ok {
+				return fmt.Errorf("expected key=value, got %q", part)
+			}
+			m.SetMapIndex(reflect.ValueOf(strings.TrimSpace(k)), reflect.ValueOf(strings.TrimSpace(v)))
+		}
+		field.Set(m)
+	default:
+		return fmt.Errorf("unsupported type %s", field.Type())
+	}
+	return nil
+}
+
+func splitList(s string) []string {
+	var parts []string
+	for _, part := range strings.Split(s, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return parts
+}
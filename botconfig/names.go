@@ -0,0 +1,96 @@
+package botconfig
+
+import (
+	"strings"
+
+	"github.com/disgoorg/disgo/cache"
+	"github.com/disgoorg/disgo/gateway"
+)
+
+var intentNames = map[string]gateway.Intents{
+	"guilds":                        gateway.IntentGuilds,
+	"guild_members":                 gateway.IntentGuildMembers,
+	"guild_moderation":              gateway.IntentGuildModeration,
+	"guild_expressions":             gateway.IntentGuildExpressions,
+	"guild_integrations":            gateway.IntentGuildIntegrations,
+	"guild_webhooks":                gateway.IntentGuildWebhooks,
+	"guild_invites":                 gateway.IntentGuildInvites,
+	"guild_voice_states":            gateway.IntentGuildVoiceStates,
+	"guild_presences":               gateway.IntentGuildPresences,
+	"guild_messages":                gateway.IntentGuildMessages,
+	"guild_message_reactions":       gateway.IntentGuildMessageReactions,
+	"guild_message_typing":          gateway.IntentGuildMessageTyping,
+	"direct_messages":               gateway.IntentDirectMessages,
+	"direct_message_reactions":      gateway.IntentDirectMessageReactions,
+	"direct_message_typing":         gateway.IntentDirectMessageTyping,
+	"message_content":               gateway.IntentMessageContent,
+	"guild_scheduled_events":        gateway.IntentGuildScheduledEvents,
+	"auto_moderation_configuration": gateway.IntentAutoModerationConfiguration,
+	"auto_moderation_execution":     gateway.IntentAutoModerationExecution,
+	"guild_message_polls":           gateway.IntentGuildMessagePolls,
+	"direct_message_polls":          gateway.IntentDirectMessagePolls,
+	"non_privileged":                gateway.IntentsNonPrivileged,
+	"privileged":                    gateway.IntentsPrivileged,
+	"all":                           gateway.IntentsAll,
+	"none":                          gateway.IntentsNone,
+	"default":                       gateway.IntentsDefault,
+}
+
+var cacheFlagNames = map[string]cache.Flags{
+	"guilds":                  cache.FlagGuilds,
+	"guild_scheduled_events":  cache.FlagGuildScheduledEvents,
+	"members":                 cache.FlagMembers,
+	"thread_members":          cache.FlagThreadMembers,
+	"messages":                cache.FlagMessages,
+	"presences":               cache.FlagPresences,
+	"channels":                cache.FlagChannels,
+	"roles":                   cache.FlagRoles,
+	"emojis":                  cache.FlagEmojis,
+	"stickers":                cache.FlagStickers,
+	"voice_states":            cache.FlagVoiceStates,
+	"stage_instances":         cache.FlagStageInstances,
+	"guild_soundboard_sounds": cache.FlagGuildSoundboardSounds,
+	"all":                     cache.FlagsAll,
+	"none":                    cache.FlagsNone,
+}
+
+// cachePolicy returns the cache.ConfigOpt for a named policy or false if the policy is unknown
+type cachePolicy func(policy string) (cache.ConfigOpt, bool)
+
+var cachePolicies = map[string]cachePolicy{
+	"guilds":                 basicCachePolicy(cache.WithGuildCachePolicy),
+	"channels":               basicCachePolicy(cache.WithChannelCachePolicy),
+	"stage_instances":        basicCachePolicy(cache.WithStageInstanceCachePolicy),
+	"guild_scheduled_events": basicCachePolicy(cache.WithGuildScheduledEventCachePolicy),
+	"roles":                  basicCachePolicy(cache.WithRoleCachePolicy),
+	"members": func(policy string) (cache.ConfigOpt, bool) {
+		if policy == "pending" {
+			return cache.WithMemberCachePolicy(cache.PolicyMembersPending), true
+		}
+		return basicCachePolicy(cache.WithMemberCachePolicy)(policy)
+	},
+	"thread_members": basicCachePolicy(cache.WithThreadMemberCachePolicy),
+	"presences":      basicCachePolicy(cache.WithPresenceCachePolicy),
+	"voice_states":   basicCachePolicy(cache.WithVoiceStateCachePolicy),
+	"messages":       basicCachePolicy(cache.WithMessageCachePolicy),
+	"emojis":         basicCachePolicy(cache.WithEmojiCachePolicy),
+	"stickers":       basicCachePolicy(cache.WithStickerCachePolicy),
+}
+
+// basicCachePolicy supports the "all" & "none" policies
+func basicCachePolicy[T any](opt func(policy cache.Policy[T]) cache.ConfigOpt) cachePolicy {
+	return func(policy string) (cache.ConfigOpt, bool) {
+		switch policy {
+		case "all":
+			return opt(cache.PolicyAll[T]), true
+		case "none":
+			return opt(cache.PolicyNone[T]), true
+		}
+		return nil, false
+	}
+}
+
+// normalizeName makes names case-insensitive & accepts '-' as separator
+func normalizeName(name string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(name)), "-", "_")
+}
@@ -9,6 +9,8 @@ type ApplicationCommandCreate interface {
 	json.Marshaler
 	Type() ApplicationCommandType
 	CommandName() string
+	// Validate validates the command against the documented limits of the Discord API.
+	Validate() error
 	applicationCommandCreate()
 }
 
@@ -50,6 +50,32 @@ func (AttachmentKeep) attachmentUpdate() {}
 type AttachmentCreate struct {
 	ID          int    `json:"id"`
 	Description string `json:"description"`
+	// Filename is the name of the attachment. It is only required for attachments referencing an UploadedFilename
+	Filename string `json:"filename,omitempty"`
+	// UploadedFilename references a file which has been uploaded to an AttachmentUpload.UploadURL
+	UploadedFilename string `json:"uploaded_filename,omitempty"`
 }
 
 func (AttachmentCreate) attachmentUpdate() {}
+
+// AttachmentUploadsCreate is used to request upload slots for multiple files via rest.Channels.CreateAttachmentUploads
+type AttachmentUploadsCreate struct {
+	Files []AttachmentUploadCreate `json:"files"`
+}
+
+// AttachmentUploadCreate is used to request an upload slot for a file via rest.Channels.CreateAttachmentUploads
+type AttachmentUploadCreate struct {
+	// ID is used to match the AttachmentUpload in the response
+	ID       int    `json:"id,string"`
+	Filename string `json:"filename"`
+	FileSize int64  `json:"file_size"`
+	IsClip   bool   `json:"is_clip,omitempty"`
+}
+
+// AttachmentUpload is an upload slot for a file. The file needs to be uploaded via a PUT request to the UploadURL.
+// Afterward it can be referenced in a message via File.UploadedFilename or AttachmentCreate.UploadedFilename.
+type AttachmentUpload struct {
+	ID             int    `json:"id"`
+	UploadURL      string `json:"upload_url"`
+	UploadFilename string `json:"upload_filename"`
+}
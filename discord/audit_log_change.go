@@ -0,0 +1,363 @@
+package discord
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/disgoorg/json/v2"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// UnmarshalAuditLogChange unmarshals the OldValue and NewValue of the AuditLogChange into T.
+// Values which are not present in the change are returned as nil.
+func UnmarshalAuditLogChange[T any](c AuditLogChange) (oldValue *T, newValue *T, err error) {
+	if oldValue, err = unmarshalAuditLogValue[T](c.OldValue); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal old value of %s: %w", c.Key, err)
+	}
+	if newValue, err = unmarshalAuditLogValue[T](c.NewValue); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal new value of %s: %w", c.Key, err)
+	}
+	return oldValue, newValue, nil
+}
+
+func unmarshalAuditLogValue[T any](data json.RawMessage) (*T, error) {
+	if isAuditLogValueEmpty(data) {
+		return nil, nil
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+func isAuditLogValueEmpty(data json.RawMessage) bool {
+	return len(data) == 0 || bytes.Equal(data, []byte("null"))
+}
+
+// DecodedAuditLogChange is an AuditLogChange with its OldValue and NewValue decoded into their typed values.
+//
+// The values have the following types depending on the key:
+//   - ids like AuditLogChangeKeyChannelID: snowflake.ID
+//   - AuditLogChangeKeyPermissions, AuditLogChangeKeyAllow, AuditLogChangeKeyDeny: Permissions
+//   - AuditLogChangeKeyPermissionOverwrites: PermissionOverwrites
+//   - AuditLogChangeKeyRoleAdd, AuditLogChangeKeyRoleRemove: []PartialRole
+//   - AuditLogChangeKeyType: ChannelType, WebhookType, IntegrationType or int depending on the AuditLogEvent
+//   - AuditLogChangeKeyAFKTimeout, AuditLogChangeKeyRateLimitPerUser, AuditLogChangeKeyMaxAge: time.Duration
+//   - AuditLogChangeKeyAutoArchiveDuration, AuditLogChangeKeyDefaultAutoArchiveDuration: AutoArchiveDuration
+//   - AuditLogChangeKeyCommunicationDisabledUntil: time.Time
+//   - levels like AuditLogChangeKeyVerificationLevel: their respective type like VerificationLevel
+//   - other known keys: string, int or bool
+//   - unknown keys: json.RawMessage
+//
+// Values which are not present in the change are nil.
+type DecodedAuditLogChange struct {
+	Key      AuditLogChangeKey
+	OldValue any
+	NewValue any
+}
+
+// Decode decodes the OldValue and NewValue of the AuditLogChange into their typed values.
+// The AuditLogEvent of the AuditLogEntry is required as some keys like AuditLogChangeKeyType have different types per event.
+func (c AuditLogChange) Decode(event AuditLogEvent) (DecodedAuditLogChange, error) {
+	decode := auditLogChangeDecoder(c.Key, event)
+	decoded := DecodedAuditLogChange{Key: c.Key}
+
+	var err error
+	if !isAuditLogValueEmpty(c.OldValue) {
+		if decoded.OldValue, err = decode(c.OldValue); err != nil {
+			return decoded, fmt.Errorf("failed to decode old value of %s: %w", c.Key, err)
+		}
+	}
+	if !isAuditLogValueEmpty(c.NewValue) {
+		if decoded.NewValue, err = decode(c.NewValue); err != nil {
+			return decoded, fmt.Errorf("failed to decode new value of %s: %w", c.Key, err)
+		}
+	}
+	return decoded, nil
+}
+
+// DecodeChanges decodes all AuditLogChange(s) of the AuditLogEntry. See AuditLogChange.Decode.
+func (e AuditLogEntry) DecodeChanges() ([]DecodedAuditLogChange, error) {
+	changes := make([]DecodedAuditLogChange, len(e.Changes))
+	for i, change := range e.Changes {
+		decoded, err := change.Decode(e.ActionType)
+		if err != nil {
+			return nil, err
+		}
+		changes[i] = decoded
+	}
+	return changes, nil
+}
+
+// ChangeSummary returns a human-readable summary of all changes of the AuditLogEntry with one change per line.
+// Changes which can't be decoded are rendered with their raw JSON values.
+func (e AuditLogEntry) ChangeSummary() string {
+	lines := make([]string, 0, len(e.Changes))
+	for _, change := range e.Changes {
+		decoded, err := change.Decode(e.ActionType)
+		if err != nil {
+			decoded = DecodedAuditLogChange{Key: change.Key}
+			if !isAuditLogValueEmpty(change.OldValue) {
+				decoded.OldValue = change.OldValue
+			}
+			if !isAuditLogValueEmpty(change.NewValue) {
+				decoded.NewValue = change.NewValue
+			}
+		}
+		lines = append(lines, decoded.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// String returns a human-readable summary of the change like `name: "old" -> "new"`
+func (c DecodedAuditLogChange) String() string {
+	switch c.Key {
+	case AuditLogChangeKeyRoleAdd:
+		return "added roles: " + formatPartialRoles(c.NewValue)
+	case AuditLogChangeKeyRoleRemove:
+		return "removed roles: " + formatPartialRoles(c.NewValue)
+	}
+
+	oldPermissions, oldOk := c.OldValue.(Permissions)
+	newPermissions, newOk := c.NewValue.(Permissions)
+	if oldOk || newOk {
+		return fmt.Sprintf("%s: %s", c.Key, diffPermissions(oldPermissions, newPermissions))
+	}
+
+	oldOverwrites, oldOk := c.OldValue.(PermissionOverwrites)
+	newOverwrites, newOk := c.NewValue.(PermissionOverwrites)
+	if oldOk || newOk {
+		return fmt.Sprintf("%s: %s", c.Key, diffPermissionOverwrites(oldOverwrites, newOverwrites))
+	}
+
+	switch {
+	case c.OldValue == nil && c.NewValue == nil:
+		return fmt.Sprintf("%s: unchanged", c.Key)
+	case c.OldValue == nil:
+		return fmt.Sprintf("%s: set to %s", c.Key, formatAuditLogValue(c.NewValue))
+	case c.NewValue == nil:
+		return fmt.Sprintf("%s: removed %s", c.Key, formatAuditLogValue(c.OldValue))
+	default:
+		return fmt.Sprintf("%s: %s -> %s", c.Key, formatAuditLogValue(c.OldValue), formatAuditLogValue(c.NewValue))
+	}
+}
+
+func auditLogChangeDecoder(key AuditLogChangeKey, event AuditLogEvent) func(data json.RawMessage) (any, error) {
+	switch key {
+	case AuditLogChangeKeyAFKChannelID, AuditLogChangeKeyApplicationID, AuditLogChangeKeyChannelID, AuditLogChangeKeyGuildID,
+		AuditLogChangeKeyID, AuditLogChangeKeyInviterID, AuditLogChangeKeyOwnerID, AuditLogChangeKeyPublicUpdatesChannelID,
+		AuditLogChangeKeyRulesChannelID, AuditLogChangeKeySystemChannelID, AuditLogChangeKeyWidgetChannelID:
+		return decodeAuditLogValue[snowflake.ID]
+
+	case AuditLogChangeKeyAllow, AuditLogChangeKeyDeny, AuditLogChangeKeyPermissions:
+		return decodeAuditLogValue[Permissions]
+
+	case AuditLogChangeKeyPermissionOverwrites:
+		return func(data json.RawMessage) (any, error) {
+			var v []UnmarshalPermissionOverwrite
+			if err := json.Unmarshal(data, &v); err != nil {
+				return nil, err
+			}
+			overwrites := make(PermissionOverwrites, len(v))
+			for i := range v {
+				overwrites[i] = v[i].PermissionOverwrite
+			}
+			return overwrites, nil
+		}
+
+	case AuditLogChangeKeyRoleAdd, AuditLogChangeKeyRoleRemove:
+		return decodeAuditLogValue[[]PartialRole]
+
+	case AuditLogChangeKeyType:
+		switch {
+		case event >= AuditLogEventChannelCreate && event <= AuditLogEventChannelDelete, event >= AuditLogThreadCreate && event <= AuditLogThreadDelete:
+			return decodeAuditLogValue[ChannelType]
+		case event >= AuditLogEventWebhookCreate && event <= AuditLogEventWebhookDelete:
+			return decodeAuditLogValue[WebhookType]
+		case event >= AuditLogEventIntegrationCreate && event <= AuditLogEventIntegrationDelete:
+			return decodeAuditLogValue[IntegrationType]
+		default:
+			return decodeAuditLogValue[json.RawMessage]
+		}
+
+	case AuditLogChangeKeyAFKTimeout, AuditLogChangeKeyRateLimitPerUser, AuditLogChangeKeyMaxAge:
+		return func(data json.RawMessage) (any, error) {
+			var seconds int
+			if err := json.Unmarshal(data, &seconds); err != nil {
+				return nil, err
+			}
+			return time.Duration(seconds) * time.Second, nil
+		}
+
+	case AuditLogChangeKeyAutoArchiveDuration, AuditLogChangeKeyDefaultAutoArchiveDuration:
+		return decodeAuditLogValue[AutoArchiveDuration]
+
+	case AuditLogChangeKeyCommunicationDisabledUntil:
+		return decodeAuditLogValue[time.Time]
+
+	case AuditLogChangeKeyDefaultMessageNotifications:
+		return decodeAuditLogValue[MessageNotificationsLevel]
+	case AuditLogChangeKeyExplicitContentFilter:
+		return decodeAuditLogValue[ExplicitContentFilterLevel]
+	case AuditLogChangeKeyMFALevel:
+		return decodeAuditLogValue[MFALevel]
+	case AuditLogChangeKeyVerificationLevel:
+		return decodeAuditLogValue[VerificationLevel]
+	case AuditLogChangeKeyPrivacyLevel:
+		return decodeAuditLogValue[ScheduledEventPrivacyLevel]
+	case AuditLogChangeKeyStatus:
+		return decodeAuditLogValue[ScheduledEventStatus]
+	case AuditLogChangeKeyEntityType:
+		return decodeAuditLogValue[ScheduledEventEntityType]
+	case AuditLogChangeKeyFormatType:
+		return decodeAuditLogValue[StickerFormatType]
+
+	case AuditLogChangeKeyArchived, AuditLogChangeKeyAvailable, AuditLogChangeKeyDeaf, AuditLogChangeKeyEnableEmoticons,
+		AuditLogChangeKeyHoist, AuditLogChangeKeyInvitable, AuditLogChangeKeyLocked, AuditLogChangeKeyMentionable,
+		AuditLogChangeKeyMute, AuditLogChangeKeyNSFW, AuditLogChangeKeyTemporary, AuditLogChangeKeyWidgetEnabled:
+		return decodeAuditLogValue[bool]
+
+	case AuditLogChangeKeyBitrate, AuditLogChangeKeyColor, AuditLogChangeKeyExpireBehavior, AuditLogChangeKeyExpireGracePeriod,
+		AuditLogChangeKeyMaxUses, AuditLogChangeKeyPosition, AuditLogChangeKeyPruneDeleteDays, AuditLogChangeKeyUserLimit,
+		AuditLogChangeKeyUses:
+		return decodeAuditLogValue[int]
+
+	case AuditLogChangeKeyAsset, AuditLogChangeKeyAvatarHash, AuditLogChangeKeyBannerHash, AuditLogChangeKeyCode,
+		AuditLogChangeKeyDescription, AuditLogChangeKeyDiscoverySplashHash, AuditLogChangeKeyIconHash, AuditLogChangeKeyLocation,
+		AuditLogChangeKeyName, AuditLogChangeKeyNick, AuditLogChangeKeyPreferredLocale, AuditLogChangeKeyRegion,
+		AuditLogChangeKeySplashHash, AuditLogChangeKeyTags, AuditLogChangeKeyTopic, AuditLogChangeKeyUnicodeEmoji,
+		AuditLogChangeKeyVanityURLCode:
+		return decodeAuditLogValue[string]
+
+	default:
+		return decodeAuditLogValue[json.RawMessage]
+	}
+}
+
+func decodeAuditLogValue[T any](data json.RawMessage) (any, error) {
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func formatAuditLogValue(v any) string {
+	switch v := v.(type) {
+	case string:
+		return fmt.Sprintf("%q", v)
+	case time.Time:
+		return v.Format(time.RFC3339)
+	case json.RawMessage:
+		return string(v)
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func formatPartialRoles(v any) string {
+	roles, _ := v.([]PartialRole)
+	if len(roles) == 0 {
+		return "none"
+	}
+	names := make([]string, len(roles))
+	for i, role := range roles {
+		names[i] = fmt.Sprintf("%s (%s)", role.Name, role.ID)
+	}
+	return strings.Join(names, ", ")
+}
+
+// diffPermissions returns the added permissions prefixed with + and the removed permissions prefixed with -
+func diffPermissions(oldPermissions Permissions, newPermissions Permissions) string {
+	var diff []string
+	for _, name := range permissionNames(newPermissions &^ oldPermissions) {
+		diff = append(diff, "+"+name)
+	}
+	for _, name := range permissionNames(oldPermissions &^ newPermissions) {
+		diff = append(diff, "-"+name)
+	}
+	if len(diff) == 0 {
+		return "unchanged"
+	}
+	return strings.Join(diff, ", ")
+}
+
+// permissionNames returns the sorted names of all permissions
+func permissionNames(p Permissions) []string {
+	var names []string
+	for permission, name := range permissions {
+		if p.Has(permission) {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+func diffPermissionOverwrites(oldOverwrites PermissionOverwrites, newOverwrites PermissionOverwrites) string {
+	var diff []string
+	for _, overwrite := range newOverwrites {
+		oldOverwrite, ok := oldOverwrites.Get(overwrite.Type(), overwrite.ID())
+		if !ok {
+			diff = append(diff, "added "+formatPermissionOverwrite(overwrite, nil))
+			continue
+		}
+		if summary := formatPermissionOverwrite(overwrite, oldOverwrite); summary != "" {
+			diff = append(diff, "changed "+summary)
+		}
+	}
+	for _, overwrite := range oldOverwrites {
+		if _, ok := newOverwrites.Get(overwrite.Type(), overwrite.ID()); !ok {
+			diff = append(diff, "removed "+formatPermissionOverwriteTarget(overwrite))
+		}
+	}
+	if len(diff) == 0 {
+		return "unchanged"
+	}
+	return strings.Join(diff, "; ")
+}
+
+func formatPermissionOverwriteTarget(overwrite PermissionOverwrite) string {
+	if overwrite.Type() == PermissionOverwriteTypeMember {
+		return "member " + overwrite.ID().String()
+	}
+	return "role " + overwrite.ID().String()
+}
+
+// formatPermissionOverwrite formats the changes of the allow & deny permissions compared to the old overwrite.
+// It returns an empty string if nothing changed.
+func formatPermissionOverwrite(overwrite PermissionOverwrite, oldOverwrite PermissionOverwrite) string {
+	allow, deny := permissionOverwriteValues(overwrite)
+	oldAllow, oldDeny := permissionOverwriteValues(oldOverwrite)
+	if oldOverwrite != nil && allow == oldAllow && deny == oldDeny {
+		return ""
+	}
+
+	var parts []string
+	if allow != oldAllow {
+		parts = append(parts, "allow "+diffPermissions(oldAllow, allow))
+	}
+	if deny != oldDeny {
+		parts = append(parts, "deny "+diffPermissions(oldDeny, deny))
+	}
+	if len(parts) == 0 {
+		return formatPermissionOverwriteTarget(overwrite)
+	}
+	return formatPermissionOverwriteTarget(overwrite) + " (" + strings.Join(parts, ", ") + ")"
+}
+
+func permissionOverwriteValues(overwrite PermissionOverwrite) (Permissions, Permissions) {
+	switch o := overwrite.(type) {
+	case RolePermissionOverwrite:
+		return o.Allow, o.Deny
+	case MemberPermissionOverwrite:
+		return o.Allow, o.Deny
+	}
+	return PermissionsNone, PermissionsNone
+}
@@ -0,0 +1,55 @@
+package discord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/disgoorg/json/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditLogEntry_DecodeChanges(t *testing.T) {
+	t.Parallel()
+
+	var entry AuditLogEntry
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"action_type": 11,
+		"changes": [
+			{"key": "name", "old_value": "general", "new_value": "chat"},
+			{"key": "type", "old_value": 0, "new_value": 5},
+			{"key": "rate_limit_per_user", "new_value": 30},
+			{"key": "permission_overwrites",
+				"old_value": [{"id": "1", "type": 0, "allow": "1024", "deny": "0"}, {"id": "2", "type": 1, "allow": "0", "deny": "0"}],
+				"new_value": [{"id": "1", "type": 0, "allow": "1024", "deny": "2048"}]}
+		]
+	}`), &entry))
+
+	changes, err := entry.DecodeChanges()
+	require.NoError(t, err)
+	assert.Equal(t, DecodedAuditLogChange{Key: AuditLogChangeKeyName, OldValue: "general", NewValue: "chat"}, changes[0])
+	assert.Equal(t, DecodedAuditLogChange{Key: AuditLogChangeKeyType, OldValue: ChannelTypeGuildText, NewValue: ChannelTypeGuildNews}, changes[1])
+	assert.Equal(t, DecodedAuditLogChange{Key: AuditLogChangeKeyRateLimitPerUser, NewValue: 30 * time.Second}, changes[2])
+
+	oldName, newName, err := UnmarshalAuditLogChange[string](entry.Changes[0])
+	require.NoError(t, err)
+	assert.Equal(t, "general", *oldName)
+	assert.Equal(t, "chat", *newName)
+
+	assert.Equal(t, `name: "general" -> "chat"
+type: 0 -> 5
+rate_limit_per_user: set to 30s
+permission_overwrites: changed role 1 (deny +Send Messages); removed member 2`, entry.ChangeSummary())
+}
+
+func TestDecodedAuditLogChange_String(t *testing.T) {
+	t.Parallel()
+
+	change, err := AuditLogChange{Key: AuditLogChangeKeyPermissions, OldValue: json.RawMessage(`"2"`), NewValue: json.RawMessage(`"20"`)}.Decode(AuditLogEventRoleUpdate)
+	require.NoError(t, err)
+	assert.Equal(t, "permissions: +Ban Members, +Manage Channels, -Kick Members", change.String())
+
+	change, err = AuditLogChange{Key: AuditLogChangeKeyRoleAdd, NewValue: json.RawMessage(`[{"id": "1", "name": "Moderator"}]`)}.Decode(AuditLogEventMemberRoleUpdate)
+	require.NoError(t, err)
+	assert.Equal(t, "added roles: Moderator (1)", change.String())
+}
@@ -20,9 +20,14 @@ type Payload interface {
 type MultipartBuffer struct {
 	Buffer      *bytes.Buffer
 	ContentType string
+	// Payload is the payload which has been encoded as payload_json
+	Payload any
 }
 
-// PayloadWithFiles returns the given payload as multipart body with all files in it
+// PayloadWithFiles returns the given payload as multipart body with all files in it.
+// All files are buffered in memory.
+//
+// Deprecated: use NewMultipartBody which streams the files instead.
 func PayloadWithFiles(v any, files ...*File) (*MultipartBuffer, error) {
 	buffer := &bytes.Buffer{}
 	writer := multipart.NewWriter(buffer)
@@ -46,13 +51,10 @@ func PayloadWithFiles(v any, files ...*File) (*MultipartBuffer, error) {
 	}
 
 	for i, file := range files {
-		var name string
-		if file.Flags.Has(FileFlagSpoiler) {
-			name = "SPOILER_" + file.Name
-		} else {
-			name = file.Name
+		if file.UploadedFilename != "" {
+			continue
 		}
-		part, err = writer.CreatePart(partHeader(fmt.Sprintf(`form-data; name="files[%d]"; filename="%s"`, i, name), "application/octet-stream"))
+		part, err = writer.CreatePart(filePartHeader(i, file))
 		if err != nil {
 			return nil, err
 		}
@@ -65,9 +67,14 @@ func PayloadWithFiles(v any, files ...*File) (*MultipartBuffer, error) {
 	return &MultipartBuffer{
 		Buffer:      buffer,
 		ContentType: writer.FormDataContentType(),
+		Payload:     v,
 	}, nil
 }
 
+func filePartHeader(i int, file *File) textproto.MIMEHeader {
+	return partHeader(fmt.Sprintf(`form-data; name="files[%d]"; filename="%s"`, i, file.filename()), "application/octet-stream")
+}
+
 func partHeader(contentDisposition string, contentType string) textproto.MIMEHeader {
 	return textproto.MIMEHeader{
 		"Content-Disposition": []string{contentDisposition},
@@ -78,6 +85,15 @@ func partHeader(contentDisposition string, contentType string) textproto.MIMEHea
 func parseAttachments(files []*File) []AttachmentCreate {
 	var attachments []AttachmentCreate
 	for i, file := range files {
+		if file.UploadedFilename != "" {
+			attachments = append(attachments, AttachmentCreate{
+				ID:               i,
+				Description:      file.Description,
+				Filename:         file.filename(),
+				UploadedFilename: file.UploadedFilename,
+			})
+			continue
+		}
 		if file.Description == "" {
 			continue
 		}
@@ -99,12 +115,32 @@ func NewFile(name string, description string, reader io.Reader, flags ...FileFla
 	}
 }
 
+// NewUploadedFile returns a new File struct which references a file already uploaded to an AttachmentUpload.UploadURL
+func NewUploadedFile(name string, description string, uploadedFilename string, flags ...FileFlags) *File {
+	return &File{
+		Name:             name,
+		Description:      description,
+		UploadedFilename: uploadedFilename,
+		Flags:            FileFlagsNone.Add(flags...),
+	}
+}
+
 // File holds all information about a given io.Reader
 type File struct {
 	Name        string
 	Description string
 	Reader      io.Reader
 	Flags       FileFlags
+	// UploadedFilename references a file already uploaded to an AttachmentUpload.UploadURL.
+	// If set, the Reader is ignored and the file is not sent in the request body.
+	UploadedFilename string
+}
+
+func (f File) filename() string {
+	if f.Flags.Has(FileFlagSpoiler) {
+		return "SPOILER_" + f.Name
+	}
+	return f.Name
 }
 
 // FileFlags are used to mark Attachments as Spoiler
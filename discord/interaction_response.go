@@ -8,6 +8,11 @@ type InteractionResponseType int
 // It is used to indicate that the HTTP response should be 202 Accepted
 const InteractionResponseTypeAcknowledge InteractionResponseType = -1
 
+// InteractionResponseTypeUnavailable is stricly internal and will never be sent to discord.
+//
+// It is used to indicate that the HTTP response should be 503 Service Unavailable, for example while the Client shuts down
+const InteractionResponseTypeUnavailable InteractionResponseType = -2
+
 // Constants for the InteractionResponseType(s)
 const (
 	InteractionResponseTypePong InteractionResponseType = iota + 1
@@ -0,0 +1,144 @@
+package discord
+
+import (
+	"time"
+
+	"github.com/disgoorg/json/v2"
+	"github.com/disgoorg/omit"
+	"github.com/disgoorg/snowflake/v2"
+
+	"github.com/disgoorg/disgo/internal/flags"
+)
+
+// Lobby is a group of users of a game which can chat with each other and optionally be linked to a Channel
+type Lobby struct {
+	ID            snowflake.ID      `json:"id"`
+	ApplicationID snowflake.ID      `json:"application_id"`
+	Metadata      map[string]string `json:"metadata"`
+	Members       []LobbyMember     `json:"members"`
+	LinkedChannel GuildChannel      `json:"linked_channel,omitempty"`
+}
+
+func (l *Lobby) UnmarshalJSON(data []byte) error {
+	type lobby Lobby
+	var v struct {
+		LinkedChannel *UnmarshalChannel `json:"linked_channel"`
+		lobby
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*l = Lobby(v.lobby)
+	if v.LinkedChannel != nil {
+		if channel, ok := v.LinkedChannel.Channel.(GuildChannel); ok {
+			l.LinkedChannel = channel
+		}
+	}
+	return nil
+}
+
+// Member returns the LobbyMember with the given user ID
+func (l Lobby) Member(userID snowflake.ID) (LobbyMember, bool) {
+	for _, member := range l.Members {
+		if member.ID == userID {
+			return member, true
+		}
+	}
+	return LobbyMember{}, false
+}
+
+func (l Lobby) CreatedAt() time.Time {
+	return l.ID.Time()
+}
+
+// LobbyMember is a user in a Lobby
+type LobbyMember struct {
+	ID       snowflake.ID      `json:"id"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+	Flags    LobbyMemberFlags  `json:"flags,omitempty"`
+}
+
+// LobbyMemberFlags are the flags of a LobbyMember
+type LobbyMemberFlags int
+
+const (
+	// LobbyMemberFlagCanLinkLobby allows the LobbyMember to link a Channel to the Lobby
+	LobbyMemberFlagCanLinkLobby LobbyMemberFlags = 1 << iota
+	LobbyMemberFlagsNone        LobbyMemberFlags = 0
+)
+
+// Add allows you to add multiple bits together, producing a new bit
+func (f LobbyMemberFlags) Add(bits ...LobbyMemberFlags) LobbyMemberFlags {
+	return flags.Add(f, bits...)
+}
+
+// Remove allows you to subtract multiple bits from the first, producing a new bit
+func (f LobbyMemberFlags) Remove(bits ...LobbyMemberFlags) LobbyMemberFlags {
+	return flags.Remove(f, bits...)
+}
+
+// Has will ensure that the bit includes all the bits entered
+func (f LobbyMemberFlags) Has(bits ...LobbyMemberFlags) bool {
+	return flags.Has(f, bits...)
+}
+
+// Missing will check whether the bit is missing any one of the bits
+func (f LobbyMemberFlags) Missing(bits ...LobbyMemberFlags) bool {
+	return flags.Missing(f, bits...)
+}
+
+// LobbyCreate is used to create a Lobby
+type LobbyCreate struct {
+	Metadata           map[string]string `json:"metadata,omitempty"`
+	Members            []LobbyMember     `json:"members,omitempty"`
+	IdleTimeoutSeconds int               `json:"idle_timeout_seconds,omitempty"`
+}
+
+// LobbyUpdate is used to update a Lobby. Members replaces all members of the Lobby.
+type LobbyUpdate struct {
+	Metadata           omit.Omit[map[string]string] `json:"metadata,omitzero"`
+	Members            *[]LobbyMember               `json:"members,omitempty"`
+	IdleTimeoutSeconds *int                         `json:"idle_timeout_seconds,omitempty"`
+}
+
+// LobbyMemberAdd is used to add a user to a Lobby or update an existing LobbyMember
+type LobbyMemberAdd struct {
+	Metadata map[string]string `json:"metadata,omitempty"`
+	Flags    LobbyMemberFlags  `json:"flags,omitempty"`
+}
+
+// LobbyJoin is used to join a Lobby by its secret on behalf of a user. The Lobby is created if no Lobby with the secret exists.
+type LobbyJoin struct {
+	Secret             string            `json:"secret"`
+	LobbyMetadata      map[string]string `json:"lobby_metadata,omitempty"`
+	MemberMetadata     map[string]string `json:"member_metadata,omitempty"`
+	IdleTimeoutSeconds int               `json:"idle_timeout_seconds,omitempty"`
+}
+
+// LobbyChannelLink is used to link a Channel to a Lobby or to unlink it if ChannelID is nil
+type LobbyChannelLink struct {
+	ChannelID *snowflake.ID `json:"channel_id,omitempty"`
+}
+
+// LobbyMessage is a message sent in a Lobby
+type LobbyMessage struct {
+	ID        snowflake.ID      `json:"id"`
+	Type      MessageType       `json:"type"`
+	Content   string            `json:"content"`
+	LobbyID   snowflake.ID      `json:"lobby_id"`
+	ChannelID snowflake.ID      `json:"channel_id"`
+	Author    User              `json:"author"`
+	Metadata  map[string]string `json:"metadata,omitempty"`
+	Flags     MessageFlags      `json:"flags"`
+}
+
+func (m LobbyMessage) CreatedAt() time.Time {
+	return m.ID.Time()
+}
+
+// LobbyMessageCreate is used to send a LobbyMessage
+type LobbyMessageCreate struct {
+	Content  string            `json:"content"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
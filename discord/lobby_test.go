@@ -0,0 +1,33 @@
+package discord
+
+import (
+	"testing"
+
+	"github.com/disgoorg/json/v2"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLobby_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	data := []byte(`{
+		"id": "1",
+		"application_id": "2",
+		"metadata": {"mode": "ranked"},
+		"members": [{"id": "3", "flags": 1}],
+		"linked_channel": {"id": "4", "guild_id": "5", "type": 0, "name": "lobby"}
+	}`)
+
+	var lobby Lobby
+	require.NoError(t, json.Unmarshal(data, &lobby))
+
+	assert.Equal(t, snowflake.ID(1), lobby.ID)
+	assert.Equal(t, "ranked", lobby.Metadata["mode"])
+	member, ok := lobby.Member(3)
+	require.True(t, ok)
+	assert.True(t, member.Flags.Has(LobbyMemberFlagCanLinkLobby))
+	require.NotNil(t, lobby.LinkedChannel)
+	assert.Equal(t, snowflake.ID(4), lobby.LinkedChannel.ID())
+}
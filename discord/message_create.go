@@ -27,7 +27,7 @@ func (MessageCreate) interactionCallbackData() {}
 func (m MessageCreate) ToBody() (any, error) {
 	if len(m.Files) > 0 {
 		m.Attachments = parseAttachments(m.Files)
-		return PayloadWithFiles(m, m.Files...)
+		return NewMultipartBody(m, m.Files...)
 	}
 	return m, nil
 }
@@ -36,7 +36,7 @@ func (m MessageCreate) ToResponseBody(response InteractionResponse) (any, error)
 	if len(m.Files) > 0 {
 		m.Attachments = parseAttachments(m.Files)
 		response.Data = m
-		return PayloadWithFiles(response, m.Files...)
+		return NewMultipartBody(response, m.Files...)
 	}
 	return response, nil
 }
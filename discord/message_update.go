@@ -25,7 +25,7 @@ func (m MessageUpdate) ToBody() (any, error) {
 			}
 			*m.Attachments = append(*m.Attachments, attachmentCreate)
 		}
-		return PayloadWithFiles(m, m.Files...)
+		return NewMultipartBody(m, m.Files...)
 	}
 	return m, nil
 }
@@ -38,7 +38,7 @@ func (m MessageUpdate) ToResponseBody(response InteractionResponse) (any, error)
 			}
 			*m.Attachments = append(*m.Attachments, attachmentCreate)
 		}
-		return PayloadWithFiles(response, m.Files...)
+		return NewMultipartBody(response, m.Files...)
 	}
 	return response, nil
 }
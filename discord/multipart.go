@@ -0,0 +1,236 @@
+package discord
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/textproto"
+	"os"
+	"sync"
+
+	"github.com/disgoorg/json/v2"
+)
+
+// multipartMemoryLimit is the maximum size of a non-seekable File which is kept in memory. Bigger files are spooled to a temporary file.
+const multipartMemoryLimit = 1 << 20
+
+// NewMultipartBody returns the given payload as streaming multipart body with all files in it.
+// Files with a Reader implementing io.Seeker are streamed from their current position each time the body is read.
+// Other readers are spooled once to memory or, if they are bigger than 1 MiB, to a temporary file.
+// Files with an UploadedFilename are only referenced in the payload and not part of the body.
+// The MultipartBody must be closed to remove temporary files.
+func NewMultipartBody(v any, files ...*File) (*MultipartBody, error) {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	body := &MultipartBody{
+		Payload:     v,
+		PayloadJSON: payload,
+		boundary:    multipart.NewWriter(nil).Boundary(),
+	}
+
+	for i, file := range files {
+		if file.UploadedFilename != "" {
+			// already uploaded files are only referenced in the payload
+			continue
+		}
+		part, err := newMultipartFile(filePartHeader(i, file), file.Reader)
+		if err != nil {
+			_ = body.Close()
+			return nil, fmt.Errorf("failed to prepare file %q: %w", file.Name, err)
+		}
+		body.files = append(body.files, part)
+	}
+
+	// write the body without any file contents to calculate the exact content length
+	counter := &countingWriter{}
+	if err = body.writeTo(counter, false); err != nil {
+		_ = body.Close()
+		return nil, err
+	}
+	body.contentLength = counter.n
+	for _, file := range body.files {
+		body.contentLength += file.size
+	}
+
+	return body, nil
+}
+
+// MultipartBody is a multipart/form-data body which streams its files instead of buffering them in memory.
+// Each call to Reader rewinds all files, so the body can be sent multiple times for retries.
+type MultipartBody struct {
+	// Payload is the payload which is encoded as payload_json
+	Payload any
+	// PayloadJSON is the encoded payload_json part
+	PayloadJSON []byte
+
+	boundary      string
+	files         []*multipartFile
+	contentLength int64
+
+	mu      sync.Mutex
+	current *multipartReader
+}
+
+// ContentType returns the Content-Type header of the MultipartBody including the boundary
+func (b *MultipartBody) ContentType() string {
+	return "multipart/form-data; boundary=" + b.boundary
+}
+
+// ContentLength returns the exact length of the MultipartBody in bytes
+func (b *MultipartBody) ContentLength() int64 {
+	return b.contentLength
+}
+
+// Reader returns a new io.ReadCloser which streams the MultipartBody from the start.
+// A previously returned reader is closed, as all readers share the underlying files.
+func (b *MultipartBody) Reader() (io.ReadCloser, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.current != nil {
+		_ = b.current.Close()
+	}
+	b.current = &multipartReader{body: b}
+	return b.current, nil
+}
+
+// Close closes the current reader and removes all temporary files
+func (b *MultipartBody) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.current != nil {
+		_ = b.current.Close()
+		b.current = nil
+	}
+
+	var errs []error
+	for _, file := range b.files {
+		if file.temp == nil {
+			continue
+		}
+		errs = append(errs, file.temp.Close(), os.Remove(file.temp.Name()))
+		file.temp = nil
+	}
+	return errors.Join(errs...)
+}
+
+func (b *MultipartBody) writeTo(w io.Writer, withFiles bool) error {
+	writer := multipart.NewWriter(w)
+	if err := writer.SetBoundary(b.boundary); err != nil {
+		return err
+	}
+
+	part, err := writer.CreatePart(partHeader(`form-data; name="payload_json"`, "application/json"))
+	if err != nil {
+		return err
+	}
+	if _, err = part.Write(b.PayloadJSON); err != nil {
+		return err
+	}
+
+	for _, file := range b.files {
+		if part, err = writer.CreatePart(file.header); err != nil {
+			return err
+		}
+		if !withFiles {
+			continue
+		}
+		if _, err = file.reader.Seek(file.offset, io.SeekStart); err != nil {
+			return err
+		}
+		if _, err = io.CopyN(part, file.reader, file.size); err != nil {
+			return err
+		}
+	}
+	return writer.Close()
+}
+
+func newMultipartFile(header textproto.MIMEHeader, reader io.Reader) (*multipartFile, error) {
+	if seeker, ok := reader.(io.ReadSeeker); ok {
+		offset, err := seeker.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return nil, err
+		}
+		end, err := seeker.Seek(0, io.SeekEnd)
+		if err != nil {
+			return nil, err
+		}
+		return &multipartFile{header: header, reader: seeker, offset: offset, size: end - offset}, nil
+	}
+
+	buf, err := io.ReadAll(io.LimitReader(reader, multipartMemoryLimit+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(buf) <= multipartMemoryLimit {
+		return &multipartFile{header: header, reader: bytes.NewReader(buf), size: int64(len(buf))}, nil
+	}
+
+	temp, err := os.CreateTemp("", "disgo-upload-*")
+	if err != nil {
+		return nil, err
+	}
+	size, err := io.Copy(temp, io.MultiReader(bytes.NewReader(buf), reader))
+	if err != nil {
+		_ = temp.Close()
+		_ = os.Remove(temp.Name())
+		return nil, err
+	}
+	return &multipartFile{header: header, reader: temp, size: size, temp: temp}, nil
+}
+
+type multipartFile struct {
+	header textproto.MIMEHeader
+	reader io.ReadSeeker
+	offset int64
+	size   int64
+	temp   *os.File
+}
+
+// multipartReader lazily starts writing the MultipartBody into an io.Pipe on the first Read
+type multipartReader struct {
+	body *MultipartBody
+	once sync.Once
+	pr   *io.PipeReader
+	done chan struct{}
+}
+
+func (r *multipartReader) Read(p []byte) (int, error) {
+	r.once.Do(func() {
+		pr, pw := io.Pipe()
+		r.pr = pr
+		r.done = make(chan struct{})
+		go func() {
+			defer close(r.done)
+			_ = pw.CloseWithError(r.body.writeTo(pw, true))
+		}()
+	})
+	if r.pr == nil {
+		return 0, io.ErrClosedPipe
+	}
+	return r.pr.Read(p)
+}
+
+// Close stops the writing goroutine and waits for it to exit, so the files can safely be rewound afterwards
+func (r *multipartReader) Close() error {
+	r.once.Do(func() {})
+	if r.pr == nil {
+		return nil
+	}
+	err := r.pr.Close()
+	<-r.done
+	return err
+}
+
+type countingWriter struct {
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+	return len(p), nil
+}
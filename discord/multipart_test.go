@@ -0,0 +1,57 @@
+package discord
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"mime/multipart"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultipartBody(t *testing.T) {
+	t.Parallel()
+
+	large := strings.Repeat("a", multipartMemoryLimit+1)
+	body, err := NewMultipartBody(MessageCreate{Content: "test"},
+		NewFile("seekable.txt", "", strings.NewReader("seekable")),
+		NewFile("small.txt", "", bytes.NewBufferString("small")),
+		NewFile("large.txt", "", io.MultiReader(strings.NewReader(large))),
+	)
+	require.NoError(t, err)
+	temp := body.files[2].temp
+	require.NotNil(t, temp)
+
+	for range 2 {
+		reader, err := body.Reader()
+		require.NoError(t, err)
+		data, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		assert.Equal(t, body.ContentLength(), int64(len(data)))
+
+		_, params, err := mime.ParseMediaType(body.ContentType())
+		require.NoError(t, err)
+		mr := multipart.NewReader(bytes.NewReader(data), params["boundary"])
+
+		var parts []string
+		for {
+			part, err := mr.NextPart()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			content, err := io.ReadAll(part)
+			require.NoError(t, err)
+			parts = append(parts, string(content))
+		}
+		assert.Equal(t, []string{`{"content":"test"}`, "seekable", "small", large}, parts)
+	}
+
+	assert.NoError(t, body.Close())
+	_, err = os.Stat(temp.Name())
+	assert.True(t, os.IsNotExist(err))
+}
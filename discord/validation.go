@@ -0,0 +1,811 @@
+package discord
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Documented limits of the Discord API which are checked by the Validate methods.
+const (
+	MaxMessageContentLength        = 2000
+	MaxMessageEmbeds               = 10
+	MaxMessageStickers             = 3
+	MaxMessageFiles                = 10
+	MaxMessageActionRows           = 5
+	MaxMessageComponentsV2         = 40
+	MaxMessageTextDisplayLength    = 4000
+	MaxMessageNonceLength          = 25
+	MaxWebhookUsernameLength       = 80
+	MaxThreadNameLength            = 100
+	MaxEmbedTitleLength            = 256
+	MaxEmbedDescriptionLength      = 4096
+	MaxEmbedFields                 = 25
+	MaxEmbedFieldNameLength        = 256
+	MaxEmbedFieldValueLength       = 1024
+	MaxEmbedFooterTextLength       = 2048
+	MaxEmbedAuthorNameLength       = 256
+	MaxEmbedsTotalLength           = 6000
+	MaxActionRowComponents         = 5
+	MaxCustomIDLength              = 100
+	MaxButtonLabelLength           = 80
+	MaxSelectMenuPlaceholderLength = 150
+	MaxSelectMenuValues            = 25
+	MaxSelectMenuOptions           = 25
+	MaxSelectMenuOptionLength      = 100
+	MaxTextInputLabelLength        = 45
+	MaxTextInputLength             = 4000
+	MaxTextInputPlaceholderLength  = 100
+	MaxSectionComponents           = 3
+	MaxMediaDescriptionLength      = 1024
+	MaxMediaGalleryItems           = 10
+	MaxModalTitleLength            = 45
+	MaxModalComponents             = 5
+	MaxPollQuestionLength          = 300
+	MaxPollAnswers                 = 10
+	MaxPollAnswerLength            = 55
+	MaxPollDurationHours           = 768
+	MaxCommandNameLength           = 32
+	MaxCommandDescriptionLength    = 100
+	MaxCommandOptions              = 25
+	MaxCommandChoices              = 25
+	MaxCommandChoiceNameLength     = 100
+	MaxCommandChoiceValueLength    = 100
+	MaxCommandOptionLength         = 6000
+	MaxCommandTotalLength          = 4000
+)
+
+var commandNameRegex = regexp.MustCompile(`^[-_\p{L}\p{N}\p{Devanagari}\p{Thai}]{1,32}$`)
+
+// Validator is implemented by payloads which can be validated against the documented limits of the Discord API before they are sent.
+type Validator interface {
+	// Validate returns ValidationErrors if the payload exceeds any documented limit or nil if it is valid.
+	Validate() error
+}
+
+var (
+	_ Validator = (*MessageCreate)(nil)
+	_ Validator = (*MessageUpdate)(nil)
+	_ Validator = (*WebhookMessageCreate)(nil)
+	_ Validator = (*WebhookMessageUpdate)(nil)
+	_ Validator = (*ThreadChannelPostCreate)(nil)
+	_ Validator = (*ModalCreate)(nil)
+	_ Validator = (*InteractionResponse)(nil)
+	_ Validator = (*SlashCommandCreate)(nil)
+	_ Validator = (*UserCommandCreate)(nil)
+	_ Validator = (*MessageCommandCreate)(nil)
+	_ Validator = (*EntryPointCommandCreate)(nil)
+)
+
+var _ error = (*ValidationError)(nil)
+
+// ValidationError is a single violation of a documented limit of the Discord API.
+type ValidationError struct {
+	// Path is the dot separated path of the field like `embeds.0.fields.2.value`, matching the paths of the field errors returned by Discord.
+	Path    string
+	Message string
+}
+
+// Error returns the ValidationError formatted as string
+func (e ValidationError) Error() string {
+	if e.Path == "" {
+		return e.Message
+	}
+	return e.Path + ": " + e.Message
+}
+
+var _ error = (*ValidationErrors)(nil)
+
+// ValidationErrors holds all ValidationError(s) of a payload.
+type ValidationErrors []ValidationError
+
+// Error returns all ValidationError(s) formatted as string
+func (e ValidationErrors) Error() string {
+	errs := make([]string, len(e))
+	for i, err := range e {
+		errs[i] = err.Error()
+	}
+	return "invalid payload: " + strings.Join(errs, ", ")
+}
+
+// Get returns the ValidationError(s) of the field at the given path
+func (e ValidationErrors) Get(path string) []ValidationError {
+	var errs []ValidationError
+	for _, err := range e {
+		if err.Path == path {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// validator collects ValidationError(s) and tracks limits which span a whole payload.
+type validator struct {
+	errs             ValidationErrors
+	componentIDs     map[int]struct{}
+	customIDs        map[string]struct{}
+	components       int
+	textDisplayChars int
+}
+
+func (v *validator) err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
+
+func (v *validator) add(path string, format string, a ...any) {
+	v.errs = append(v.errs, ValidationError{Path: path, Message: fmt.Sprintf(format, a...)})
+}
+
+// merge adds the ValidationErrors of a nested payload at the given path
+func (v *validator) merge(path string, err error) {
+	errs, ok := err.(ValidationErrors)
+	if !ok {
+		if err != nil {
+			v.add(path, "%s", err)
+		}
+		return
+	}
+	for _, e := range errs {
+		e.Path = joinPath(path, e.Path)
+		v.errs = append(v.errs, e)
+	}
+}
+
+func (v *validator) length(path string, s string, minLength int, maxLength int) int {
+	n := utf8.RuneCountInString(s)
+	if n < minLength {
+		if minLength == 1 {
+			v.add(path, "must not be empty")
+		} else {
+			v.add(path, "must be %d or more in length", minLength)
+		}
+	} else if maxLength > 0 && n > maxLength {
+		v.add(path, "must be %d or fewer in length", maxLength)
+	}
+	return n
+}
+
+func (v *validator) count(path string, n int, minCount int, maxCount int) {
+	if n < minCount {
+		v.add(path, "must contain at least %d elements", minCount)
+	} else if maxCount > 0 && n > maxCount {
+		v.add(path, "must contain %d or fewer elements", maxCount)
+	}
+}
+
+func (v *validator) between(path string, n int, minValue int, maxValue int) {
+	if n < minValue || n > maxValue {
+		v.add(path, "must be between %d and %d", minValue, maxValue)
+	}
+}
+
+func (v *validator) localizations(path string, localizations map[Locale]string, minLength int, maxLength int) {
+	for locale, s := range localizations {
+		v.length(joinPath(path, string(locale)), s, minLength, maxLength)
+	}
+}
+
+func joinPath(path string, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func indexPath(path string, i int) string {
+	return joinPath(path, strconv.Itoa(i))
+}
+
+func validate(fn func(v *validator)) error {
+	v := &validator{}
+	fn(v)
+	return v.err()
+}
+
+// Validate validates the MessageCreate against the documented limits of the Discord API
+func (m MessageCreate) Validate() error {
+	return validate(func(v *validator) {
+		v.length("nonce", m.Nonce, 0, MaxMessageNonceLength)
+		v.count("sticker_ids", len(m.StickerIDs), 0, MaxMessageStickers)
+		v.count("files", len(m.Files), 0, MaxMessageFiles)
+		v.message(m.Flags, &m.Content, &m.Embeds, &m.Components, m.Poll, len(m.StickerIDs))
+	})
+}
+
+// Validate validates the MessageUpdate against the documented limits of the Discord API
+func (m MessageUpdate) Validate() error {
+	return validate(func(v *validator) {
+		var flags MessageFlags
+		if m.Flags != nil {
+			flags = *m.Flags
+		}
+		v.count("files", len(m.Files), 0, MaxMessageFiles)
+		v.message(flags, m.Content, m.Embeds, m.Components, nil, 0)
+	})
+}
+
+// Validate validates the WebhookMessageCreate against the documented limits of the Discord API
+func (m WebhookMessageCreate) Validate() error {
+	return validate(func(v *validator) {
+		v.length("username", m.Username, 0, MaxWebhookUsernameLength)
+		v.length("thread_name", m.ThreadName, 0, MaxThreadNameLength)
+		v.count("files", len(m.Files), 0, MaxMessageFiles)
+		v.message(m.Flags, &m.Content, &m.Embeds, &m.Components, m.Poll, 0)
+	})
+}
+
+// Validate validates the WebhookMessageUpdate against the documented limits of the Discord API
+func (m WebhookMessageUpdate) Validate() error {
+	return validate(func(v *validator) {
+		var flags MessageFlags
+		if m.Flags != nil {
+			flags = *m.Flags
+		}
+		v.count("files", len(m.Files), 0, MaxMessageFiles)
+		v.message(flags, m.Content, m.Embeds, m.Components, m.Poll, 0)
+	})
+}
+
+// Validate validates the ThreadChannelPostCreate against the documented limits of the Discord API
+func (c ThreadChannelPostCreate) Validate() error {
+	return validate(func(v *validator) {
+		v.length("name", c.Name, 1, MaxThreadNameLength)
+		v.merge("message", c.Message.Validate())
+	})
+}
+
+// Validate validates the InteractionResponse data against the documented limits of the Discord API
+func (r InteractionResponse) Validate() error {
+	return validate(func(v *validator) {
+		if data, ok := r.Data.(Validator); ok {
+			v.merge("data", data.Validate())
+		}
+	})
+}
+
+func (v *validator) message(flags MessageFlags, content *string, embeds *[]Embed, components *[]LayoutComponent, poll *PollCreate, stickers int) {
+	componentsV2 := flags.Has(MessageFlagIsComponentsV2)
+	if content != nil {
+		v.length("content", *content, 0, MaxMessageContentLength)
+		if componentsV2 && *content != "" {
+			v.add("content", "must be empty when MessageFlagIsComponentsV2 is set")
+		}
+	}
+	if embeds != nil {
+		v.count("embeds", len(*embeds), 0, MaxMessageEmbeds)
+		if componentsV2 && len(*embeds) > 0 {
+			v.add("embeds", "must be empty when MessageFlagIsComponentsV2 is set")
+		}
+		var total int
+		for i, embed := range *embeds {
+			total += v.embed(indexPath("embeds", i), embed)
+		}
+		if total > MaxEmbedsTotalLength {
+			v.add("embeds", "combined length of all embeds must be %d or fewer", MaxEmbedsTotalLength)
+		}
+	}
+	if poll != nil {
+		if componentsV2 {
+			v.add("poll", "must be empty when MessageFlagIsComponentsV2 is set")
+		}
+		v.poll("poll", *poll)
+	}
+	if componentsV2 && stickers > 0 {
+		v.add("sticker_ids", "must be empty when MessageFlagIsComponentsV2 is set")
+	}
+	if components == nil {
+		return
+	}
+
+	if !componentsV2 {
+		v.count("components", len(*components), 0, MaxMessageActionRows)
+	}
+	for i, component := range *components {
+		path := indexPath("components", i)
+		if _, ok := component.(ActionRowComponent); !ok && !componentsV2 {
+			if _, ok = component.(UnknownComponent); !ok {
+				v.add(path, "component type %d requires MessageFlagIsComponentsV2", component.Type())
+				continue
+			}
+		}
+		v.component(path, component, false)
+	}
+	if componentsV2 {
+		if v.components > MaxMessageComponentsV2 {
+			v.add("components", "must contain %d or fewer components including nested components", MaxMessageComponentsV2)
+		}
+		if v.textDisplayChars > MaxMessageTextDisplayLength {
+			v.add("components", "combined length of all text displays must be %d or fewer", MaxMessageTextDisplayLength)
+		}
+	}
+}
+
+// Validate validates the Embed against the documented limits of the Discord API
+func (e Embed) Validate() error {
+	return validate(func(v *validator) {
+		if v.embed("", e) > MaxEmbedsTotalLength {
+			v.add("", "combined length must be %d or fewer", MaxEmbedsTotalLength)
+		}
+	})
+}
+
+// embed validates the Embed and returns its combined length which counts towards MaxEmbedsTotalLength
+func (v *validator) embed(path string, e Embed) int {
+	total := v.length(joinPath(path, "title"), e.Title, 0, MaxEmbedTitleLength)
+	total += v.length(joinPath(path, "description"), e.Description, 0, MaxEmbedDescriptionLength)
+	v.count(joinPath(path, "fields"), len(e.Fields), 0, MaxEmbedFields)
+	for i, field := range e.Fields {
+		fieldPath := indexPath(joinPath(path, "fields"), i)
+		total += v.length(joinPath(fieldPath, "name"), field.Name, 1, MaxEmbedFieldNameLength)
+		total += v.length(joinPath(fieldPath, "value"), field.Value, 1, MaxEmbedFieldValueLength)
+	}
+	if e.Footer != nil {
+		total += v.length(joinPath(path, "footer.text"), e.Footer.Text, 1, MaxEmbedFooterTextLength)
+	}
+	if e.Author != nil {
+		total += v.length(joinPath(path, "author.name"), e.Author.Name, 1, MaxEmbedAuthorNameLength)
+	}
+	return total
+}
+
+func (v *validator) poll(path string, p PollCreate) {
+	if p.Question.Text == nil {
+		v.add(joinPath(path, "question.text"), "must not be empty")
+	} else {
+		v.length(joinPath(path, "question.text"), *p.Question.Text, 1, MaxPollQuestionLength)
+	}
+	v.count(joinPath(path, "answers"), len(p.Answers), 1, MaxPollAnswers)
+	for i, answer := range p.Answers {
+		answerPath := joinPath(indexPath(joinPath(path, "answers"), i), "poll_media.text")
+		if answer.Text == nil {
+			if answer.Emoji == nil {
+				v.add(answerPath, "must not be empty")
+			}
+			continue
+		}
+		v.length(answerPath, *answer.Text, 0, MaxPollAnswerLength)
+	}
+	// a duration of 0 uses the default duration of Discord
+	v.between(joinPath(path, "duration"), p.Duration, 0, MaxPollDurationHours)
+}
+
+// Validate validates the ModalCreate against the documented limits of the Discord API
+func (m ModalCreate) Validate() error {
+	return validate(func(v *validator) {
+		v.length("custom_id", m.CustomID, 1, MaxCustomIDLength)
+		v.length("title", m.Title, 1, MaxModalTitleLength)
+		v.count("components", len(m.Components), 1, MaxModalComponents)
+		for i, component := range m.Components {
+			path := indexPath("components", i)
+			switch c := component.(type) {
+			case ActionRowComponent:
+				if len(c.Components) != 1 {
+					v.add(joinPath(path, "components"), "must contain exactly 1 text input in modals")
+				} else if _, ok := c.Components[0].(TextInputComponent); !ok {
+					v.add(indexPath(joinPath(path, "components"), 0), "component type %d is not allowed in modals", c.Components[0].Type())
+				}
+			case TextDisplayComponent, UnknownComponent:
+			default:
+				v.add(path, "component type %d is not allowed in modals", component.Type())
+				continue
+			}
+			v.component(path, component, true)
+		}
+	})
+}
+
+// Validate validates the ActionRowComponent against the documented limits of the Discord API
+func (c ActionRowComponent) Validate() error { return validateComponent(c) }
+
+// Validate validates the ButtonComponent against the documented limits of the Discord API
+func (c ButtonComponent) Validate() error { return validateComponent(c) }
+
+// Validate validates the StringSelectMenuComponent against the documented limits of the Discord API
+func (c StringSelectMenuComponent) Validate() error { return validateComponent(c) }
+
+// Validate validates the UserSelectMenuComponent against the documented limits of the Discord API
+func (c UserSelectMenuComponent) Validate() error { return validateComponent(c) }
+
+// Validate validates the RoleSelectMenuComponent against the documented limits of the Discord API
+func (c RoleSelectMenuComponent) Validate() error { return validateComponent(c) }
+
+// Validate validates the MentionableSelectMenuComponent against the documented limits of the Discord API
+func (c MentionableSelectMenuComponent) Validate() error { return validateComponent(c) }
+
+// Validate validates the ChannelSelectMenuComponent against the documented limits of the Discord API
+func (c ChannelSelectMenuComponent) Validate() error { return validateComponent(c) }
+
+// Validate validates the TextInputComponent against the documented limits of the Discord API
+func (c TextInputComponent) Validate() error {
+	return validate(func(v *validator) {
+		v.component("", c, true)
+	})
+}
+
+// Validate validates the SectionComponent against the documented limits of the Discord API
+func (c SectionComponent) Validate() error { return validateComponent(c) }
+
+// Validate validates the TextDisplayComponent against the documented limits of the Discord API
+func (c TextDisplayComponent) Validate() error { return validateComponent(c) }
+
+// Validate validates the ThumbnailComponent against the documented limits of the Discord API
+func (c ThumbnailComponent) Validate() error { return validateComponent(c) }
+
+// Validate validates the MediaGalleryComponent against the documented limits of the Discord API
+func (c MediaGalleryComponent) Validate() error { return validateComponent(c) }
+
+// Validate validates the FileComponent against the documented limits of the Discord API
+func (c FileComponent) Validate() error { return validateComponent(c) }
+
+// Validate validates the SeparatorComponent against the documented limits of the Discord API
+func (c SeparatorComponent) Validate() error { return validateComponent(c) }
+
+// Validate validates the ContainerComponent against the documented limits of the Discord API
+func (c ContainerComponent) Validate() error { return validateComponent(c) }
+
+func validateComponent(c Component) error {
+	return validate(func(v *validator) {
+		v.component("", c, false)
+		if v.textDisplayChars > MaxMessageTextDisplayLength {
+			v.add("", "combined length of all text displays must be %d or fewer", MaxMessageTextDisplayLength)
+		}
+	})
+}
+
+// component validates the Component and all of its sub components.
+// It also tracks the uniqueness of ids & custom ids and the total number of components.
+func (v *validator) component(path string, c Component, modal bool) {
+	v.components++
+	if id := c.GetID(); id != 0 {
+		if id < 0 {
+			v.add(joinPath(path, "id"), "must be positive")
+		} else if _, ok := v.componentIDs[id]; ok {
+			v.add(joinPath(path, "id"), "must be unique, %d is already used", id)
+		} else {
+			if v.componentIDs == nil {
+				v.componentIDs = map[int]struct{}{}
+			}
+			v.componentIDs[id] = struct{}{}
+		}
+	}
+
+	switch c := c.(type) {
+	case ActionRowComponent:
+		componentsPath := joinPath(path, "components")
+		v.count(componentsPath, len(c.Components), 1, MaxActionRowComponents)
+		for i, component := range c.Components {
+			subPath := indexPath(componentsPath, i)
+			switch component.(type) {
+			case StringSelectMenuComponent, UserSelectMenuComponent, RoleSelectMenuComponent, MentionableSelectMenuComponent, ChannelSelectMenuComponent:
+				if len(c.Components) > 1 {
+					v.add(subPath, "select menus must be the only component in an action row")
+				}
+			case TextInputComponent:
+				if !modal {
+					v.add(subPath, "text inputs are only allowed in modals")
+				}
+			}
+			v.component(subPath, component, modal)
+		}
+
+	case ButtonComponent:
+		v.button(path, c)
+
+	case StringSelectMenuComponent:
+		v.selectMenu(path, c.CustomID, c.Placeholder, c.MinValues, c.MaxValues, 0)
+		optionsPath := joinPath(path, "options")
+		v.count(optionsPath, len(c.Options), 1, MaxSelectMenuOptions)
+		if c.MaxValues > len(c.Options) && len(c.Options) > 0 {
+			v.add(joinPath(path, "max_values"), "must not exceed the number of options")
+		}
+		for i, option := range c.Options {
+			optionPath := indexPath(optionsPath, i)
+			v.length(joinPath(optionPath, "label"), option.Label, 1, MaxSelectMenuOptionLength)
+			v.length(joinPath(optionPath, "value"), option.Value, 1, MaxSelectMenuOptionLength)
+			v.length(joinPath(optionPath, "description"), option.Description, 0, MaxSelectMenuOptionLength)
+		}
+
+	case UserSelectMenuComponent:
+		v.selectMenu(path, c.CustomID, c.Placeholder, c.MinValues, c.MaxValues, len(c.DefaultValues))
+
+	case RoleSelectMenuComponent:
+		v.selectMenu(path, c.CustomID, c.Placeholder, c.MinValues, c.MaxValues, len(c.DefaultValues))
+
+	case MentionableSelectMenuComponent:
+		v.selectMenu(path, c.CustomID, c.Placeholder, c.MinValues, c.MaxValues, len(c.DefaultValues))
+
+	case ChannelSelectMenuComponent:
+		v.selectMenu(path, c.CustomID, c.Placeholder, c.MinValues, c.MaxValues, len(c.DefaultValues))
+
+	case TextInputComponent:
+		v.customID(joinPath(path, "custom_id"), c.CustomID)
+		v.length(joinPath(path, "label"), c.Label, 1, MaxTextInputLabelLength)
+		v.length(joinPath(path, "placeholder"), c.Placeholder, 0, MaxTextInputPlaceholderLength)
+		v.length(joinPath(path, "value"), c.Value, 0, MaxTextInputLength)
+		if c.MinLength != nil {
+			v.between(joinPath(path, "min_length"), *c.MinLength, 0, MaxTextInputLength)
+		}
+		if c.MaxLength != 0 {
+			v.between(joinPath(path, "max_length"), c.MaxLength, 1, MaxTextInputLength)
+			if c.MinLength != nil && *c.MinLength > c.MaxLength {
+				v.add(joinPath(path, "min_length"), "must not exceed max_length")
+			}
+		}
+
+	case SectionComponent:
+		componentsPath := joinPath(path, "components")
+		v.count(componentsPath, len(c.Components), 1, MaxSectionComponents)
+		for i, component := range c.Components {
+			v.component(indexPath(componentsPath, i), component, modal)
+		}
+		if c.Accessory == nil {
+			v.add(joinPath(path, "accessory"), "must not be empty")
+		} else {
+			v.component(joinPath(path, "accessory"), c.Accessory, modal)
+		}
+
+	case TextDisplayComponent:
+		v.textDisplayChars += v.length(joinPath(path, "content"), c.Content, 1, MaxMessageTextDisplayLength)
+
+	case ThumbnailComponent:
+		v.length(joinPath(path, "media.url"), c.Media.URL, 1, 0)
+		v.length(joinPath(path, "description"), c.Description, 0, MaxMediaDescriptionLength)
+
+	case MediaGalleryComponent:
+		itemsPath := joinPath(path, "items")
+		v.count(itemsPath, len(c.Items), 1, MaxMediaGalleryItems)
+		for i, item := range c.Items {
+			itemPath := indexPath(itemsPath, i)
+			v.length(joinPath(itemPath, "media.url"), item.Media.URL, 1, 0)
+			v.length(joinPath(itemPath, "description"), item.Description, 0, MaxMediaDescriptionLength)
+		}
+
+	case FileComponent:
+		if !strings.HasPrefix(c.File.URL, "attachment://") {
+			v.add(joinPath(path, "file.url"), "must use the attachment://<filename> syntax")
+		}
+
+	case ContainerComponent:
+		componentsPath := joinPath(path, "components")
+		v.count(componentsPath, len(c.Components), 1, 0)
+		for i, component := range c.Components {
+			v.component(indexPath(componentsPath, i), component, modal)
+		}
+	}
+}
+
+func (v *validator) customID(path string, customID string) {
+	v.length(path, customID, 1, MaxCustomIDLength)
+	if customID == "" {
+		return
+	}
+	if _, ok := v.customIDs[customID]; ok {
+		v.add(path, "must be unique, %q is already used", customID)
+		return
+	}
+	if v.customIDs == nil {
+		v.customIDs = map[string]struct{}{}
+	}
+	v.customIDs[customID] = struct{}{}
+}
+
+func (v *validator) button(path string, c ButtonComponent) {
+	v.length(joinPath(path, "label"), c.Label, 0, MaxButtonLabelLength)
+	switch c.Style {
+	case ButtonStyleLink:
+		v.length(joinPath(path, "url"), c.URL, 1, 0)
+		if c.CustomID != "" {
+			v.add(joinPath(path, "custom_id"), "must be empty for link buttons")
+		}
+	case ButtonStylePremium:
+		if c.SkuID == 0 {
+			v.add(joinPath(path, "sku_id"), "must not be empty for premium buttons")
+		}
+		if c.CustomID != "" || c.URL != "" || c.Label != "" || c.Emoji != nil {
+			v.add(path, "premium buttons must not have a custom_id, url, label or emoji")
+		}
+		return
+	default:
+		v.customID(joinPath(path, "custom_id"), c.CustomID)
+		if c.URL != "" {
+			v.add(joinPath(path, "url"), "must be empty for non link buttons")
+		}
+	}
+	if c.Label == "" && c.Emoji == nil {
+		v.add(path, "buttons must have a label or an emoji")
+	}
+}
+
+func (v *validator) selectMenu(path string, customID string, placeholder string, minValues *int, maxValues int, defaultValues int) {
+	v.customID(joinPath(path, "custom_id"), customID)
+	v.length(joinPath(path, "placeholder"), placeholder, 0, MaxSelectMenuPlaceholderLength)
+	if minValues != nil {
+		v.between(joinPath(path, "min_values"), *minValues, 0, MaxSelectMenuValues)
+	}
+	if maxValues != 0 {
+		v.between(joinPath(path, "max_values"), maxValues, 1, MaxSelectMenuValues)
+		if minValues != nil && *minValues > maxValues {
+			v.add(joinPath(path, "min_values"), "must not exceed max_values")
+		}
+	}
+	v.count(joinPath(path, "default_values"), defaultValues, 0, MaxSelectMenuValues)
+}
+
+// Validate validates the SlashCommandCreate against the documented limits of the Discord API
+func (c SlashCommandCreate) Validate() error {
+	return validate(func(v *validator) {
+		total := v.commandName("name", c.Name, true)
+		v.localizations("name_localizations", c.NameLocalizations, 1, MaxCommandNameLength)
+		total += v.length("description", c.Description, 1, MaxCommandDescriptionLength)
+		v.localizations("description_localizations", c.DescriptionLocalizations, 1, MaxCommandDescriptionLength)
+		total += v.commandOptions("options", c.Options, 0)
+		if total > MaxCommandTotalLength {
+			v.add("", "combined length of name, description and all options must be %d or fewer", MaxCommandTotalLength)
+		}
+	})
+}
+
+// Validate validates the UserCommandCreate against the documented limits of the Discord API
+func (c UserCommandCreate) Validate() error {
+	return validate(func(v *validator) {
+		v.commandName("name", c.Name, false)
+		v.localizations("name_localizations", c.NameLocalizations, 1, MaxCommandNameLength)
+	})
+}
+
+// Validate validates the MessageCommandCreate against the documented limits of the Discord API
+func (c MessageCommandCreate) Validate() error {
+	return validate(func(v *validator) {
+		v.commandName("name", c.Name, false)
+		v.localizations("name_localizations", c.NameLocalizations, 1, MaxCommandNameLength)
+	})
+}
+
+// Validate validates the EntryPointCommandCreate against the documented limits of the Discord API
+func (c EntryPointCommandCreate) Validate() error {
+	return validate(func(v *validator) {
+		v.commandName("name", c.Name, false)
+		v.localizations("name_localizations", c.NameLocalizations, 1, MaxCommandNameLength)
+	})
+}
+
+func (v *validator) commandName(path string, name string, chatInput bool) int {
+	n := v.length(path, name, 1, MaxCommandNameLength)
+	if chatInput && name != "" {
+		if !commandNameRegex.MatchString(name) {
+			v.add(path, "must only contain letters, numbers, - and _")
+		} else if strings.ToLower(name) != name {
+			v.add(path, "must be lowercase")
+		}
+	}
+	return n
+}
+
+// commandOptions validates the options of a command or sub command at the given depth and returns their combined length.
+// Depth 0 are the options of the command, depth 1 of a sub command group or sub command and depth 2 of a sub command in a group.
+func (v *validator) commandOptions(path string, options []ApplicationCommandOption, depth int) int {
+	v.count(path, len(options), 0, MaxCommandOptions)
+
+	var (
+		total       int
+		subCommands int
+		optional    bool
+	)
+	for i, option := range options {
+		optionPath := indexPath(path, i)
+		total += v.commandName(joinPath(optionPath, "name"), option.OptionName(), true)
+		total += v.length(joinPath(optionPath, "description"), option.OptionDescription(), 1, MaxCommandDescriptionLength)
+
+		switch o := option.(type) {
+		case ApplicationCommandOptionSubCommandGroup:
+			subCommands++
+			if depth > 0 {
+				v.add(optionPath, "sub command groups can only be nested in commands")
+			}
+			v.commandOptionLocalizations(optionPath, o.NameLocalizations, o.DescriptionLocalizations)
+			subCommandsPath := joinPath(optionPath, "options")
+			v.count(subCommandsPath, len(o.Options), 0, MaxCommandOptions)
+			for j, subCommand := range o.Options {
+				subCommandPath := indexPath(subCommandsPath, j)
+				total += v.commandName(joinPath(subCommandPath, "name"), subCommand.Name, true)
+				total += v.length(joinPath(subCommandPath, "description"), subCommand.Description, 1, MaxCommandDescriptionLength)
+				v.commandOptionLocalizations(subCommandPath, subCommand.NameLocalizations, subCommand.DescriptionLocalizations)
+				total += v.commandOptions(joinPath(subCommandPath, "options"), subCommand.Options, 2)
+			}
+			continue
+		case ApplicationCommandOptionSubCommand:
+			subCommands++
+			if depth > 0 {
+				v.add(optionPath, "sub commands can only be nested in commands or sub command groups")
+			}
+			v.commandOptionLocalizations(optionPath, o.NameLocalizations, o.DescriptionLocalizations)
+			total += v.commandOptions(joinPath(optionPath, "options"), o.Options, 1)
+			continue
+		case ApplicationCommandOptionString:
+			v.commandOptionLocalizations(optionPath, o.NameLocalizations, o.DescriptionLocalizations)
+			v.required(optionPath, o.Required, &optional)
+			v.choices(optionPath, len(o.Choices), o.Autocomplete)
+			for j, choice := range o.Choices {
+				choicePath := indexPath(joinPath(optionPath, "choices"), j)
+				total += v.choiceName(choicePath, choice.Name, choice.NameLocalizations)
+				total += v.length(joinPath(choicePath, "value"), choice.Value, 1, MaxCommandChoiceValueLength)
+			}
+			if o.MinLength != nil {
+				v.between(joinPath(optionPath, "min_length"), *o.MinLength, 0, MaxCommandOptionLength)
+			}
+			if o.MaxLength != nil {
+				v.between(joinPath(optionPath, "max_length"), *o.MaxLength, 1, MaxCommandOptionLength)
+			}
+		case ApplicationCommandOptionInt:
+			v.commandOptionLocalizations(optionPath, o.NameLocalizations, o.DescriptionLocalizations)
+			v.required(optionPath, o.Required, &optional)
+			v.choices(optionPath, len(o.Choices), o.Autocomplete)
+			for j, choice := range o.Choices {
+				choicePath := indexPath(joinPath(optionPath, "choices"), j)
+				total += v.choiceName(choicePath, choice.Name, choice.NameLocalizations)
+				total += len(strconv.Itoa(choice.Value))
+			}
+		case ApplicationCommandOptionFloat:
+			v.commandOptionLocalizations(optionPath, o.NameLocalizations, o.DescriptionLocalizations)
+			v.required(optionPath, o.Required, &optional)
+			v.choices(optionPath, len(o.Choices), o.Autocomplete)
+			for j, choice := range o.Choices {
+				choicePath := indexPath(joinPath(optionPath, "choices"), j)
+				total += v.choiceName(choicePath, choice.Name, choice.NameLocalizations)
+				total += len(strconv.FormatFloat(choice.Value, 'f', -1, 64))
+			}
+		case ApplicationCommandOptionBool:
+			v.commandOptionLocalizations(optionPath, o.NameLocalizations, o.DescriptionLocalizations)
+			v.required(optionPath, o.Required, &optional)
+		case ApplicationCommandOptionUser:
+			v.commandOptionLocalizations(optionPath, o.NameLocalizations, o.DescriptionLocalizations)
+			v.required(optionPath, o.Required, &optional)
+		case ApplicationCommandOptionChannel:
+			v.commandOptionLocalizations(optionPath, o.NameLocalizations, o.DescriptionLocalizations)
+			v.required(optionPath, o.Required, &optional)
+		case ApplicationCommandOptionRole:
+			v.commandOptionLocalizations(optionPath, o.NameLocalizations, o.DescriptionLocalizations)
+			v.required(optionPath, o.Required, &optional)
+		case ApplicationCommandOptionMentionable:
+			v.commandOptionLocalizations(optionPath, o.NameLocalizations, o.DescriptionLocalizations)
+			v.required(optionPath, o.Required, &optional)
+		case ApplicationCommandOptionAttachment:
+			v.commandOptionLocalizations(optionPath, o.NameLocalizations, o.DescriptionLocalizations)
+			v.required(optionPath, o.Required, &optional)
+		}
+	}
+	if subCommands > 0 && subCommands != len(options) {
+		v.add(path, "sub commands and sub command groups can not be mixed with other options")
+	}
+	return total
+}
+
+func (v *validator) commandOptionLocalizations(path string, nameLocalizations map[Locale]string, descriptionLocalizations map[Locale]string) {
+	v.localizations(joinPath(path, "name_localizations"), nameLocalizations, 1, MaxCommandNameLength)
+	v.localizations(joinPath(path, "description_localizations"), descriptionLocalizations, 1, MaxCommandDescriptionLength)
+}
+
+func (v *validator) required(path string, required bool, optional *bool) {
+	if !required {
+		*optional = true
+	} else if *optional {
+		v.add(joinPath(path, "required"), "required options must be listed before optional options")
+	}
+}
+
+func (v *validator) choices(path string, choices int, autocomplete bool) {
+	v.count(joinPath(path, "choices"), choices, 0, MaxCommandChoices)
+	if autocomplete && choices > 0 {
+		v.add(joinPath(path, "autocomplete"), "can not be used together with choices")
+	}
+}
+
+func (v *validator) choiceName(path string, name string, localizations map[Locale]string) int {
+	v.localizations(joinPath(path, "name_localizations"), localizations, 1, MaxCommandChoiceNameLength)
+	return v.length(joinPath(path, "name"), name, 1, MaxCommandChoiceNameLength)
+}
@@ -0,0 +1,95 @@
+package discord
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMessageCreate_Validate(t *testing.T) {
+	t.Parallel()
+
+	valid := MessageCreate{
+		Content: "hello",
+		Embeds:  []Embed{{Title: "title", Description: "description"}},
+		Components: []LayoutComponent{
+			NewActionRow(NewPrimaryButton("button", "button")),
+		},
+	}
+	assert.NoError(t, valid.Validate())
+
+	invalid := MessageCreate{
+		Embeds: make([]Embed, 11),
+		Components: []LayoutComponent{
+			NewActionRow(NewPrimaryButton("button", strings.Repeat("a", 101))),
+			NewTextDisplay("text"),
+		},
+	}
+	invalid.Embeds[0].Description = strings.Repeat("a", 4097)
+
+	var errs ValidationErrors
+	assert.True(t, errors.As(invalid.Validate(), &errs))
+	assert.Len(t, errs.Get("embeds"), 1)
+	assert.Len(t, errs.Get("embeds.0.description"), 1)
+	assert.Len(t, errs.Get("components.0.components.0.custom_id"), 1)
+	assert.Len(t, errs.Get("components.1"), 1)
+}
+
+func TestMessageCreate_ValidateComponentsV2(t *testing.T) {
+	t.Parallel()
+
+	message := MessageCreate{
+		Content: "content",
+		Flags:   MessageFlagIsComponentsV2,
+		Components: []LayoutComponent{
+			NewContainer(
+				NewTextDisplay(strings.Repeat("a", 2500)),
+				NewTextDisplay(strings.Repeat("a", 2500)),
+				NewActionRow(NewPrimaryButton("button", "button").WithID(1), NewSecondaryButton("button2", "button").WithID(1)),
+			),
+		},
+	}
+
+	var errs ValidationErrors
+	assert.True(t, errors.As(message.Validate(), &errs))
+	assert.Len(t, errs.Get("content"), 1)
+	assert.Len(t, errs.Get("components.0.components.2.components.1.id"), 1)
+	assert.Len(t, errs.Get("components"), 1)
+}
+
+func TestSlashCommandCreate_Validate(t *testing.T) {
+	t.Parallel()
+
+	command := SlashCommandCreate{
+		Name:        "Test",
+		Description: "test",
+		Options: []ApplicationCommandOption{
+			ApplicationCommandOptionString{Name: "optional", Description: "optional"},
+			ApplicationCommandOptionString{Name: "required", Description: "required", Required: true},
+		},
+	}
+
+	var errs ValidationErrors
+	assert.True(t, errors.As(command.Validate(), &errs))
+	assert.Len(t, errs.Get("name"), 1)
+	assert.Len(t, errs.Get("options.1.required"), 1)
+}
+
+func TestPollCreate_Validate(t *testing.T) {
+	t.Parallel()
+
+	text := "question"
+	answer := "answer"
+	poll := PollCreate{
+		Question: PollMedia{Text: &text},
+		Answers:  []PollMedia{{Text: &answer}},
+	}
+	assert.NoError(t, MessageCreate{Poll: &poll}.Validate())
+
+	poll.Duration = MaxPollDurationHours + 1
+	var errs ValidationErrors
+	assert.True(t, errors.As(MessageCreate{Poll: &poll}.Validate(), &errs))
+	assert.Len(t, errs.Get("poll.duration"), 1)
+}
@@ -24,7 +24,7 @@ func (m WebhookMessageUpdate) ToBody() (any, error) {
 			}
 			*m.Attachments = append(*m.Attachments, attachmentCreate)
 		}
-		return PayloadWithFiles(m, m.Files...)
+		return NewMultipartBody(m, m.Files...)
 	}
 	return m, nil
 }
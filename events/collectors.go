@@ -0,0 +1,57 @@
+package events
+
+import (
+	"context"
+
+	"github.com/disgoorg/snowflake/v2"
+
+	"github.com/disgoorg/disgo/bot"
+)
+
+// NewMessageCollector returns a new bot.Collector for MessageCreate events in the given channel which pass the filterFunc.
+// A nil filterFunc collects all messages.
+func NewMessageCollector(client *bot.Client, ctx context.Context, channelID snowflake.ID, filterFunc func(e *MessageCreate) bool, opts ...bot.CollectorConfigOpt) *bot.Collector[*MessageCreate] {
+	return bot.NewCollector(client, ctx, func(e *MessageCreate) bool {
+		return e.ChannelID == channelID && (filterFunc == nil || filterFunc(e))
+	}, opts...)
+}
+
+// NewReactionCollector returns a new bot.Collector for MessageReactionAdd events on the given message which pass the filterFunc.
+// A nil filterFunc collects all reactions. Use WithReactionDedupe to only collect one reaction per user & emoji.
+func NewReactionCollector(client *bot.Client, ctx context.Context, messageID snowflake.ID, filterFunc func(e *MessageReactionAdd) bool, opts ...bot.CollectorConfigOpt) *bot.Collector[*MessageReactionAdd] {
+	return bot.NewCollector(client, ctx, func(e *MessageReactionAdd) bool {
+		return e.MessageID == messageID && (filterFunc == nil || filterFunc(e))
+	}, opts...)
+}
+
+// NewComponentCollector returns a new bot.Collector for ComponentInteractionCreate events on the given message which pass the filterFunc.
+// A nil filterFunc collects all component interactions.
+func NewComponentCollector(client *bot.Client, ctx context.Context, messageID snowflake.ID, filterFunc func(e *ComponentInteractionCreate) bool, opts ...bot.CollectorConfigOpt) *bot.Collector[*ComponentInteractionCreate] {
+	return bot.NewCollector(client, ctx, func(e *ComponentInteractionCreate) bool {
+		return e.Message.ID == messageID && (filterFunc == nil || filterFunc(e))
+	}, opts...)
+}
+
+// WithReactionDedupe only collects the first MessageReactionAdd per user & emoji.
+// Events of other types are never deduplicated.
+func WithReactionDedupe() bot.CollectorConfigOpt {
+	return bot.WithCollectorDedupeKey(func(event bot.Event) any {
+		e, ok := event.(*MessageReactionAdd)
+		if !ok {
+			return event
+		}
+		return [2]string{e.UserID.String(), e.Emoji.Reaction()}
+	})
+}
+
+// WithComponentUserDedupe only collects the first ComponentInteractionCreate per user.
+// Events of other types are never deduplicated.
+func WithComponentUserDedupe() bot.CollectorConfigOpt {
+	return bot.WithCollectorDedupeKey(func(event bot.Event) any {
+		e, ok := event.(*ComponentInteractionCreate)
+		if !ok {
+			return event
+		}
+		return e.User().ID
+	})
+}
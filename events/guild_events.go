@@ -54,6 +54,13 @@ type GuildsReady struct {
 	*GenericEvent
 }
 
+// GuildMembersChunked is called when all discord.Member(s) of a discord.Guild were received by the bot.MemberChunkingScheduler
+type GuildMembersChunked struct {
+	*GenericGuild
+	MemberCount int
+	Attempts    int
+}
+
 // GuildBan is called when a discord.Member/discord.User is banned from the discord.Guild
 type GuildBan struct {
 	*GenericGuild
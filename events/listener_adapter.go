@@ -92,6 +92,7 @@ type ListenerAdapter struct {
 	OnGuildUnavailable         func(event *GuildUnavailable)
 	OnGuildReady               func(event *GuildReady)
 	OnGuildsReady              func(event *GuildsReady)
+	OnGuildMembersChunked      func(event *GuildMembersChunked)
 	OnGuildBan                 func(event *GuildBan)
 	OnGuildUnban               func(event *GuildUnban)
 	OnGuildAuditLogEntryCreate func(event *GuildAuditLogEntryCreate)
@@ -100,6 +101,17 @@ type ListenerAdapter struct {
 	OnGuildInviteCreate func(event *InviteCreate)
 	OnGuildInviteDelete func(event *InviteDelete)
 
+	// Lobby Events
+	OnLobbyCreate        func(event *LobbyCreate)
+	OnLobbyUpdate        func(event *LobbyUpdate)
+	OnLobbyDelete        func(event *LobbyDelete)
+	OnLobbyMemberAdd     func(event *LobbyMemberAdd)
+	OnLobbyMemberUpdate  func(event *LobbyMemberUpdate)
+	OnLobbyMemberRemove  func(event *LobbyMemberRemove)
+	OnLobbyMessageCreate func(event *LobbyMessageCreate)
+	OnLobbyMessageUpdate func(event *LobbyMessageUpdate)
+	OnLobbyMessageDelete func(event *LobbyMessageDelete)
+
 	// Guild Member Events
 	OnGuildMemberJoin   func(event *GuildMemberJoin)
 	OnGuildMemberUpdate func(event *GuildMemberUpdate)
@@ -436,6 +448,10 @@ func (l *ListenerAdapter) OnEvent(event bot.Event) {
 		if listener := l.OnGuildsReady; listener != nil {
 			listener(e)
 		}
+	case *GuildMembersChunked:
+		if listener := l.OnGuildMembersChunked; listener != nil {
+			listener(e)
+		}
 	case *GuildBan:
 		if listener := l.OnGuildBan; listener != nil {
 			listener(e)
@@ -459,6 +475,44 @@ func (l *ListenerAdapter) OnEvent(event bot.Event) {
 			listener(e)
 		}
 
+	// Lobby Events
+	case *LobbyCreate:
+		if listener := l.OnLobbyCreate; listener != nil {
+			listener(e)
+		}
+	case *LobbyUpdate:
+		if listener := l.OnLobbyUpdate; listener != nil {
+			listener(e)
+		}
+	case *LobbyDelete:
+		if listener := l.OnLobbyDelete; listener != nil {
+			listener(e)
+		}
+	case *LobbyMemberAdd:
+		if listener := l.OnLobbyMemberAdd; listener != nil {
+			listener(e)
+		}
+	case *LobbyMemberUpdate:
+		if listener := l.OnLobbyMemberUpdate; listener != nil {
+			listener(e)
+		}
+	case *LobbyMemberRemove:
+		if listener := l.OnLobbyMemberRemove; listener != nil {
+			listener(e)
+		}
+	case *LobbyMessageCreate:
+		if listener := l.OnLobbyMessageCreate; listener != nil {
+			listener(e)
+		}
+	case *LobbyMessageUpdate:
+		if listener := l.OnLobbyMessageUpdate; listener != nil {
+			listener(e)
+		}
+	case *LobbyMessageDelete:
+		if listener := l.OnLobbyMessageDelete; listener != nil {
+			listener(e)
+		}
+
 	// Member Events
 	case *GuildMemberJoin:
 		if listener := l.OnGuildMemberJoin; listener != nil {
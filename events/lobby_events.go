@@ -0,0 +1,74 @@
+package events
+
+import (
+	"github.com/disgoorg/snowflake/v2"
+
+	"github.com/disgoorg/disgo/discord"
+)
+
+// GenericLobby is called upon receiving LobbyCreate and LobbyUpdate
+type GenericLobby struct {
+	*GenericEvent
+	discord.Lobby
+}
+
+// LobbyCreate indicates that a discord.Lobby was created or the application joined a discord.Lobby
+type LobbyCreate struct {
+	*GenericLobby
+}
+
+// LobbyUpdate indicates that a discord.Lobby was updated
+type LobbyUpdate struct {
+	*GenericLobby
+}
+
+// LobbyDelete indicates that a discord.Lobby was deleted
+type LobbyDelete struct {
+	*GenericEvent
+	LobbyID snowflake.ID
+}
+
+// GenericLobbyMember is called upon receiving LobbyMemberAdd, LobbyMemberUpdate and LobbyMemberRemove
+type GenericLobbyMember struct {
+	*GenericEvent
+	LobbyID snowflake.ID
+	Member  discord.LobbyMember
+}
+
+// LobbyMemberAdd indicates that a discord.LobbyMember was added to a discord.Lobby
+type LobbyMemberAdd struct {
+	*GenericLobbyMember
+}
+
+// LobbyMemberUpdate indicates that a discord.LobbyMember was updated
+type LobbyMemberUpdate struct {
+	*GenericLobbyMember
+}
+
+// LobbyMemberRemove indicates that a discord.LobbyMember was removed from a discord.Lobby
+type LobbyMemberRemove struct {
+	*GenericLobbyMember
+}
+
+// GenericLobbyMessage is called upon receiving LobbyMessageCreate and LobbyMessageUpdate
+type GenericLobbyMessage struct {
+	*GenericEvent
+	Message discord.LobbyMessage
+}
+
+// LobbyMessageCreate indicates that a discord.LobbyMessage was sent in a discord.Lobby
+type LobbyMessageCreate struct {
+	*GenericLobbyMessage
+}
+
+// LobbyMessageUpdate indicates that a discord.LobbyMessage was updated
+type LobbyMessageUpdate struct {
+	*GenericLobbyMessage
+}
+
+// LobbyMessageDelete indicates that a discord.LobbyMessage was deleted
+type LobbyMessageDelete struct {
+	*GenericEvent
+	MessageID snowflake.ID
+	LobbyID   snowflake.ID
+}
@@ -143,7 +143,17 @@ type Gateway interface {
 	Presence() *MessageDataPresenceUpdate
 }
 
-var _ Gateway = (*gatewayImpl)(nil)
+var (
+	_ Gateway             = (*gatewayImpl)(nil)
+	_ HeartbeatAckTracker = (*gatewayImpl)(nil)
+)
+
+// HeartbeatAckTracker is implemented by Gateway(s) which track when they received the last OpcodeHeartbeatACK.
+// The default Gateway implements it.
+type HeartbeatAckTracker interface {
+	// LastHeartbeatAck returns the time the last OpcodeHeartbeatACK was received by the Gateway.
+	LastHeartbeatAck() time.Time
+}
 
 // New creates a new Gateway instance with the provided token, eventHandlerFunc, closeHandlerFunc and ConfigOpt(s).
 func New(token string, eventHandlerFunc EventHandlerFunc, closeHandlerFunc CloseHandlerFunc, opts ...ConfigOpt) Gateway {
@@ -337,6 +347,10 @@ func (g *gatewayImpl) Latency() time.Duration {
 	return g.lastHeartbeatReceived.Sub(g.lastHeartbeatSent)
 }
 
+func (g *gatewayImpl) LastHeartbeatAck() time.Time {
+	return g.lastHeartbeatReceived
+}
+
 func (g *gatewayImpl) Presence() *MessageDataPresenceUpdate {
 	return g.config.Presence
 }
@@ -59,6 +59,15 @@ const (
 	EventTypeInteractionCreate                   EventType = "INTERACTION_CREATE"
 	EventTypeInviteCreate                        EventType = "INVITE_CREATE"
 	EventTypeInviteDelete                        EventType = "INVITE_DELETE"
+	EventTypeLobbyCreate                         EventType = "LOBBY_CREATE"
+	EventTypeLobbyUpdate                         EventType = "LOBBY_UPDATE"
+	EventTypeLobbyDelete                         EventType = "LOBBY_DELETE"
+	EventTypeLobbyMemberAdd                      EventType = "LOBBY_MEMBER_ADD"
+	EventTypeLobbyMemberUpdate                   EventType = "LOBBY_MEMBER_UPDATE"
+	EventTypeLobbyMemberRemove                   EventType = "LOBBY_MEMBER_REMOVE"
+	EventTypeLobbyMessageCreate                  EventType = "LOBBY_MESSAGE_CREATE"
+	EventTypeLobbyMessageUpdate                  EventType = "LOBBY_MESSAGE_UPDATE"
+	EventTypeLobbyMessageDelete                  EventType = "LOBBY_MESSAGE_DELETE"
 	EventTypeMessageCreate                       EventType = "MESSAGE_CREATE"
 	EventTypeMessageUpdate                       EventType = "MESSAGE_UPDATE"
 	EventTypeMessageDelete                       EventType = "MESSAGE_DELETE"
@@ -567,6 +567,73 @@ type EventInviteDelete struct {
 func (EventInviteDelete) messageData() {}
 func (EventInviteDelete) eventData()   {}
 
+type EventLobbyCreate struct {
+	discord.Lobby
+}
+
+func (EventLobbyCreate) messageData() {}
+func (EventLobbyCreate) eventData()   {}
+
+type EventLobbyUpdate struct {
+	discord.Lobby
+}
+
+func (EventLobbyUpdate) messageData() {}
+func (EventLobbyUpdate) eventData()   {}
+
+type EventLobbyDelete struct {
+	ID snowflake.ID `json:"id"`
+}
+
+func (EventLobbyDelete) messageData() {}
+func (EventLobbyDelete) eventData()   {}
+
+type EventLobbyMemberAdd struct {
+	LobbyID snowflake.ID        `json:"lobby_id"`
+	Member  discord.LobbyMember `json:"member"`
+}
+
+func (EventLobbyMemberAdd) messageData() {}
+func (EventLobbyMemberAdd) eventData()   {}
+
+type EventLobbyMemberUpdate struct {
+	LobbyID snowflake.ID        `json:"lobby_id"`
+	Member  discord.LobbyMember `json:"member"`
+}
+
+func (EventLobbyMemberUpdate) messageData() {}
+func (EventLobbyMemberUpdate) eventData()   {}
+
+type EventLobbyMemberRemove struct {
+	LobbyID snowflake.ID        `json:"lobby_id"`
+	Member  discord.LobbyMember `json:"member"`
+}
+
+func (EventLobbyMemberRemove) messageData() {}
+func (EventLobbyMemberRemove) eventData()   {}
+
+type EventLobbyMessageCreate struct {
+	discord.LobbyMessage
+}
+
+func (EventLobbyMessageCreate) messageData() {}
+func (EventLobbyMessageCreate) eventData()   {}
+
+type EventLobbyMessageUpdate struct {
+	discord.LobbyMessage
+}
+
+func (EventLobbyMessageUpdate) messageData() {}
+func (EventLobbyMessageUpdate) eventData()   {}
+
+type EventLobbyMessageDelete struct {
+	ID      snowflake.ID `json:"id"`
+	LobbyID snowflake.ID `json:"lobby_id"`
+}
+
+func (EventLobbyMessageDelete) messageData() {}
+func (EventLobbyMessageDelete) eventData()   {}
+
 type EventMessageCreate struct {
 	discord.Message
 }
@@ -368,6 +368,51 @@ func UnmarshalEventData(data []byte, eventType EventType) (EventData, error) {
 		err = json.Unmarshal(data, &d)
 		eventData = d
 
+	case EventTypeLobbyCreate:
+		var d EventLobbyCreate
+		err = json.Unmarshal(data, &d)
+		eventData = d
+
+	case EventTypeLobbyUpdate:
+		var d EventLobbyUpdate
+		err = json.Unmarshal(data, &d)
+		eventData = d
+
+	case EventTypeLobbyDelete:
+		var d EventLobbyDelete
+		err = json.Unmarshal(data, &d)
+		eventData = d
+
+	case EventTypeLobbyMemberAdd:
+		var d EventLobbyMemberAdd
+		err = json.Unmarshal(data, &d)
+		eventData = d
+
+	case EventTypeLobbyMemberUpdate:
+		var d EventLobbyMemberUpdate
+		err = json.Unmarshal(data, &d)
+		eventData = d
+
+	case EventTypeLobbyMemberRemove:
+		var d EventLobbyMemberRemove
+		err = json.Unmarshal(data, &d)
+		eventData = d
+
+	case EventTypeLobbyMessageCreate:
+		var d EventLobbyMessageCreate
+		err = json.Unmarshal(data, &d)
+		eventData = d
+
+	case EventTypeLobbyMessageUpdate:
+		var d EventLobbyMessageUpdate
+		err = json.Unmarshal(data, &d)
+		eventData = d
+
+	case EventTypeLobbyMessageDelete:
+		var d EventLobbyMessageDelete
+		err = json.Unmarshal(data, &d)
+		eventData = d
+
 	case EventTypeMessageCreate:
 		var d EventMessageCreate
 		err = json.Unmarshal(data, &d)
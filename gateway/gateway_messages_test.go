@@ -0,0 +1,46 @@
+package gateway
+
+import (
+	"testing"
+
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/disgoorg/disgo/discord"
+)
+
+func TestUnmarshalEventData_LobbyMember(t *testing.T) {
+	t.Parallel()
+
+	data, err := UnmarshalEventData([]byte(`{"lobby_id":"1","member":{"id":"2","metadata":{"team":"red"},"flags":1}}`), EventTypeLobbyMemberAdd)
+	require.NoError(t, err)
+	require.IsType(t, EventLobbyMemberAdd{}, data)
+
+	e := data.(EventLobbyMemberAdd)
+	assert.Equal(t, snowflake.ID(1), e.LobbyID)
+	assert.Equal(t, discord.LobbyMember{
+		ID:       2,
+		Metadata: map[string]string{"team": "red"},
+		Flags:    discord.LobbyMemberFlagCanLinkLobby,
+	}, e.Member)
+}
+
+func TestUnmarshalEventData_LobbyMessage(t *testing.T) {
+	t.Parallel()
+
+	data, err := UnmarshalEventData([]byte(`{"id":"3","type":0,"content":"hello","lobby_id":"1","channel_id":"4","author":{"id":"2","username":"test"},"flags":0}`), EventTypeLobbyMessageCreate)
+	require.NoError(t, err)
+	require.IsType(t, EventLobbyMessageCreate{}, data)
+
+	e := data.(EventLobbyMessageCreate)
+	assert.Equal(t, snowflake.ID(3), e.ID)
+	assert.Equal(t, "hello", e.Content)
+	assert.Equal(t, snowflake.ID(1), e.LobbyID)
+	assert.Equal(t, snowflake.ID(4), e.ChannelID)
+	assert.Equal(t, snowflake.ID(2), e.Author.ID)
+
+	data, err = UnmarshalEventData([]byte(`{"id":"3","lobby_id":"1"}`), EventTypeLobbyMessageDelete)
+	require.NoError(t, err)
+	assert.Equal(t, EventLobbyMessageDelete{ID: 3, LobbyID: 1}, data)
+}
@@ -0,0 +1,144 @@
+package guildbackup
+
+import (
+	"time"
+
+	"github.com/disgoorg/snowflake/v2"
+
+	"github.com/disgoorg/disgo/discord"
+)
+
+// Version is the current version of the Backup format. Backups with a newer version can't be restored.
+const Version = 1
+
+// Backup is a versioned snapshot of a guild's configuration which can be encoded as JSON.
+// All IDs are the IDs of the source guild and are remapped when the Backup is restored.
+type Backup struct {
+	Version   int          `json:"version"`
+	CreatedAt time.Time    `json:"created_at"`
+	GuildID   snowflake.ID `json:"guild_id"`
+
+	Guild               Guild                        `json:"guild"`
+	Roles               []Role                       `json:"roles"`
+	Channels            []Channel                    `json:"channels"`
+	Emojis              []Emoji                      `json:"emojis"`
+	Stickers            []Sticker                    `json:"stickers"`
+	WelcomeScreen       *WelcomeScreen               `json:"welcome_screen,omitempty"`
+	Onboarding          *discord.GuildOnboarding     `json:"onboarding,omitempty"`
+	AutoModerationRules []discord.AutoModerationRule `json:"auto_moderation_rules"`
+}
+
+// Role returns the Role with the given ID from the Backup
+func (b Backup) Role(id snowflake.ID) (Role, bool) {
+	for _, role := range b.Roles {
+		if role.ID == id {
+			return role, true
+		}
+	}
+	return Role{}, false
+}
+
+// Channel returns the Channel with the given ID from the Backup
+func (b Backup) Channel(id snowflake.ID) (Channel, bool) {
+	for _, channel := range b.Channels {
+		if channel.ID == id {
+			return channel, true
+		}
+	}
+	return Channel{}, false
+}
+
+// Asset is an image or file downloaded from the discord CDN
+type Asset struct {
+	ContentType string `json:"content_type"`
+	Data        []byte `json:"data"`
+}
+
+// Guild holds the general settings of a guild
+type Guild struct {
+	Name                        string                             `json:"name"`
+	Description                 *string                            `json:"description,omitempty"`
+	VerificationLevel           discord.VerificationLevel          `json:"verification_level"`
+	DefaultMessageNotifications discord.MessageNotificationsLevel  `json:"default_message_notifications"`
+	ExplicitContentFilter       discord.ExplicitContentFilterLevel `json:"explicit_content_filter"`
+	AFKChannelID                *snowflake.ID                      `json:"afk_channel_id,omitempty"`
+	AFKTimeout                  int                                `json:"afk_timeout"`
+	SystemChannelID             *snowflake.ID                      `json:"system_channel_id,omitempty"`
+	SystemChannelFlags          discord.SystemChannelFlags         `json:"system_channel_flags"`
+	RulesChannelID              *snowflake.ID                      `json:"rules_channel_id,omitempty"`
+	PublicUpdatesChannelID      *snowflake.ID                      `json:"public_updates_channel_id,omitempty"`
+	SafetyAlertsChannelID       *snowflake.ID                      `json:"safety_alerts_channel_id,omitempty"`
+	PreferredLocale             string                             `json:"preferred_locale"`
+	PremiumProgressBarEnabled   bool                               `json:"premium_progress_bar_enabled"`
+	Icon                        *Asset                             `json:"icon,omitempty"`
+}
+
+// Role is a role of the guild. Managed roles of bots & integrations are not part of a Backup.
+type Role struct {
+	ID          snowflake.ID        `json:"id"`
+	Name        string              `json:"name"`
+	Colors      discord.RoleColors  `json:"colors"`
+	Hoist       bool                `json:"hoist"`
+	Position    int                 `json:"position"`
+	Permissions discord.Permissions `json:"permissions"`
+	Mentionable bool                `json:"mentionable"`
+	Emoji       *string             `json:"unicode_emoji,omitempty"`
+	Icon        *Asset              `json:"icon,omitempty"`
+}
+
+// Channel is a category or channel of the guild. Threads are not part of a Backup.
+// Only the fields matching the ChannelType are set.
+type Channel struct {
+	ID                            snowflake.ID                  `json:"id"`
+	Type                          discord.ChannelType           `json:"type"`
+	Name                          string                        `json:"name"`
+	Position                      int                           `json:"position"`
+	ParentID                      *snowflake.ID                 `json:"parent_id,omitempty"`
+	PermissionOverwrites          []PermissionOverwrite         `json:"permission_overwrites"`
+	Topic                         *string                       `json:"topic,omitempty"`
+	NSFW                          bool                          `json:"nsfw,omitempty"`
+	RateLimitPerUser              int                           `json:"rate_limit_per_user,omitempty"`
+	DefaultAutoArchiveDuration    discord.AutoArchiveDuration   `json:"default_auto_archive_duration,omitempty"`
+	DefaultThreadRateLimitPerUser int                           `json:"default_thread_rate_limit_per_user,omitempty"`
+	Bitrate                       int                           `json:"bitrate,omitempty"`
+	UserLimit                     int                           `json:"user_limit,omitempty"`
+	RTCRegion                     string                        `json:"rtc_region,omitempty"`
+	VideoQualityMode              discord.VideoQualityMode      `json:"video_quality_mode,omitempty"`
+	AvailableTags                 []discord.ChannelTag          `json:"available_tags,omitempty"`
+	DefaultReactionEmoji          *discord.DefaultReactionEmoji `json:"default_reaction_emoji,omitempty"`
+	DefaultSortOrder              *discord.DefaultSortOrder     `json:"default_sort_order,omitempty"`
+	DefaultForumLayout            discord.DefaultForumLayout    `json:"default_forum_layout,omitempty"`
+}
+
+// PermissionOverwrite is a role or member permission overwrite of a Channel
+type PermissionOverwrite struct {
+	ID    snowflake.ID                    `json:"id"`
+	Type  discord.PermissionOverwriteType `json:"type"`
+	Allow discord.Permissions             `json:"allow"`
+	Deny  discord.Permissions             `json:"deny"`
+}
+
+// Emoji is a custom emoji of the guild
+type Emoji struct {
+	ID       snowflake.ID   `json:"id"`
+	Name     string         `json:"name"`
+	Animated bool           `json:"animated"`
+	Roles    []snowflake.ID `json:"roles,omitempty"`
+	Image    *Asset         `json:"image,omitempty"`
+}
+
+// Sticker is a custom sticker of the guild
+type Sticker struct {
+	ID          snowflake.ID              `json:"id"`
+	Name        string                    `json:"name"`
+	Description string                    `json:"description"`
+	Tags        string                    `json:"tags"`
+	FormatType  discord.StickerFormatType `json:"format_type"`
+	File        *Asset                    `json:"file,omitempty"`
+}
+
+// WelcomeScreen is the welcome screen of a community guild
+type WelcomeScreen struct {
+	Enabled bool `json:"enabled"`
+	discord.GuildWelcomeScreen
+}
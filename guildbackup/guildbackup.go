@@ -0,0 +1,277 @@
+package guildbackup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/disgoorg/snowflake/v2"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/rest"
+)
+
+// maxAssetSize is the maximum size of a downloaded Asset
+const maxAssetSize = 10 << 20
+
+// New returns a new Client which exports & restores guilds using the given rest.Rest
+func New(restClient rest.Rest, opts ...ConfigOpt) *Client {
+	cfg := defaultConfig()
+	cfg.apply(opts)
+
+	return &Client{
+		Rest:   restClient,
+		config: cfg,
+	}
+}
+
+// Client exports the configuration of a guild to a Backup and restores a Backup to a guild.
+type Client struct {
+	Rest   rest.Rest
+	config config
+}
+
+// Export creates a Backup of the guild's settings, roles, categories, channels, permission overwrites, emojis, stickers,
+// welcome screen, onboarding & auto moderation rules.
+func (c *Client) Export(ctx context.Context, guildID snowflake.ID) (*Backup, error) {
+	opts := []rest.RequestOpt{rest.WithCtx(ctx)}
+
+	guild, err := c.Rest.GetGuild(guildID, false, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guild: %w", err)
+	}
+	channels, err := c.Rest.GetGuildChannels(guildID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get channels: %w", err)
+	}
+	rules, err := c.Rest.GetAutoModerationRules(guildID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get auto moderation rules: %w", err)
+	}
+
+	backup := &Backup{
+		Version:   Version,
+		CreatedAt: time.Now().UTC(),
+		GuildID:   guildID,
+		Guild: Guild{
+			Name:                        guild.Name,
+			Description:                 guild.Description,
+			VerificationLevel:           guild.VerificationLevel,
+			DefaultMessageNotifications: guild.DefaultMessageNotifications,
+			ExplicitContentFilter:       guild.ExplicitContentFilter,
+			AFKChannelID:                guild.AfkChannelID,
+			AFKTimeout:                  guild.AfkTimeout,
+			SystemChannelID:             guild.SystemChannelID,
+			SystemChannelFlags:          guild.SystemChannelFlags,
+			RulesChannelID:              guild.RulesChannelID,
+			PublicUpdatesChannelID:      guild.PublicUpdatesChannelID,
+			SafetyAlertsChannelID:       guild.SafetyAlertsChannelID,
+			PreferredLocale:             guild.PreferredLocale,
+			PremiumProgressBarEnabled:   guild.PremiumProgressBarEnabled,
+		},
+		AutoModerationRules: rules,
+	}
+	if iconURL := guild.IconURL(); iconURL != nil {
+		if backup.Guild.Icon, err = c.downloadAsset(ctx, *iconURL); err != nil {
+			return nil, fmt.Errorf("failed to download guild icon: %w", err)
+		}
+	}
+
+	for _, role := range guild.Roles {
+		if role.Managed {
+			continue
+		}
+		backupRole := Role{
+			ID:          role.ID,
+			Name:        role.Name,
+			Colors:      role.RoleColors,
+			Hoist:       role.Hoist,
+			Position:    role.Position,
+			Permissions: role.Permissions,
+			Mentionable: role.Mentionable,
+			Emoji:       role.Emoji,
+		}
+		if iconURL := role.IconURL(); iconURL != nil {
+			if backupRole.Icon, err = c.downloadAsset(ctx, *iconURL); err != nil {
+				return nil, fmt.Errorf("failed to download icon of role %q: %w", role.Name, err)
+			}
+		}
+		backup.Roles = append(backup.Roles, backupRole)
+	}
+	slices.SortFunc(backup.Roles, func(a, b Role) int {
+		return a.Position - b.Position
+	})
+
+	for _, channel := range channels {
+		if backupChannel, ok := exportChannel(channel); ok {
+			backup.Channels = append(backup.Channels, backupChannel)
+		}
+	}
+	slices.SortFunc(backup.Channels, func(a, b Channel) int {
+		return a.Position - b.Position
+	})
+
+	for _, emoji := range guild.Emojis {
+		if emoji.Managed {
+			continue
+		}
+		backupEmoji := Emoji{
+			ID:       emoji.ID,
+			Name:     emoji.Name,
+			Animated: emoji.Animated,
+			Roles:    emoji.Roles,
+		}
+		var cdnOpts []discord.CDNOpt
+		if emoji.Animated {
+			cdnOpts = append(cdnOpts, discord.WithFormat(discord.FileFormatGIF))
+		}
+		if backupEmoji.Image, err = c.downloadAsset(ctx, emoji.URL(cdnOpts...)); err != nil {
+			return nil, fmt.Errorf("failed to download emoji %q: %w", emoji.Name, err)
+		}
+		backup.Emojis = append(backup.Emojis, backupEmoji)
+	}
+
+	for _, sticker := range guild.Stickers {
+		backupSticker := Sticker{
+			ID:          sticker.ID,
+			Name:        sticker.Name,
+			Description: sticker.Description,
+			Tags:        sticker.Tags,
+			FormatType:  sticker.FormatType,
+		}
+		if backupSticker.File, err = c.downloadAsset(ctx, sticker.URL()); err != nil {
+			return nil, fmt.Errorf("failed to download sticker %q: %w", sticker.Name, err)
+		}
+		backup.Stickers = append(backup.Stickers, backupSticker)
+	}
+
+	if slices.Contains(guild.Features, discord.GuildFeatureCommunity) {
+		welcomeScreen, err := c.Rest.GetGuildWelcomeScreen(guildID, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get welcome screen: %w", err)
+		}
+		backup.WelcomeScreen = &WelcomeScreen{
+			Enabled:            slices.Contains(guild.Features, discord.GuildFeatureWelcomeScreenEnabled),
+			GuildWelcomeScreen: *welcomeScreen,
+		}
+
+		if backup.Onboarding, err = c.Rest.GetGuildOnboarding(guildID, opts...); err != nil {
+			return nil, fmt.Errorf("failed to get onboarding: %w", err)
+		}
+	}
+
+	return backup, nil
+}
+
+// downloadAsset downloads the Asset from the discord CDN or returns nil if assets are not included
+func (c *Client) downloadAsset(ctx context.Context, url string) (*Asset, error) {
+	if !c.config.IncludeAssets {
+		return nil, nil
+	}
+	rq, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	rs, err := c.Rest.HTTPClient().Do(rq)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = rs.Body.Close()
+	}()
+	if rs.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", rs.StatusCode)
+	}
+
+	if rs.ContentLength > maxAssetSize {
+		return nil, fmt.Errorf("asset of %d bytes exceeds the max size of %d bytes", rs.ContentLength, maxAssetSize)
+	}
+	// read one byte more than allowed to detect assets exceeding the max size without a Content-Length
+	data, err := io.ReadAll(io.LimitReader(rs.Body, maxAssetSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxAssetSize {
+		return nil, fmt.Errorf("asset exceeds the max size of %d bytes", maxAssetSize)
+	}
+	contentType := rs.Header.Get("Content-Type")
+	if contentType == "" {
+		contentType = http.DetectContentType(data)
+	}
+	return &Asset{ContentType: contentType, Data: data}, nil
+}
+
+func exportChannel(channel discord.GuildChannel) (Channel, bool) {
+	backupChannel := Channel{
+		ID:       channel.ID(),
+		Type:     channel.Type(),
+		Name:     channel.Name(),
+		Position: channel.Position(),
+		ParentID: channel.ParentID(),
+	}
+	for _, overwrite := range channel.PermissionOverwrites() {
+		backupOverwrite := PermissionOverwrite{
+			Type: overwrite.Type(),
+		}
+		switch o := overwrite.(type) {
+		case discord.RolePermissionOverwrite:
+			backupOverwrite.ID, backupOverwrite.Allow, backupOverwrite.Deny = o.RoleID, o.Allow, o.Deny
+		case discord.MemberPermissionOverwrite:
+			backupOverwrite.ID, backupOverwrite.Allow, backupOverwrite.Deny = o.UserID, o.Allow, o.Deny
+		default:
+			continue
+		}
+		backupChannel.PermissionOverwrites = append(backupChannel.PermissionOverwrites, backupOverwrite)
+	}
+
+	switch ch := channel.(type) {
+	case discord.GuildCategoryChannel:
+	case discord.GuildTextChannel:
+		backupChannel.Topic = ch.Topic()
+		backupChannel.NSFW = ch.NSFW()
+		backupChannel.RateLimitPerUser = ch.RateLimitPerUser()
+		backupChannel.DefaultAutoArchiveDuration = ch.DefaultAutoArchiveDuration()
+	case discord.GuildNewsChannel:
+		backupChannel.Topic = ch.Topic()
+		backupChannel.NSFW = ch.NSFW()
+		backupChannel.RateLimitPerUser = ch.RateLimitPerUser()
+		backupChannel.DefaultAutoArchiveDuration = ch.DefaultAutoArchiveDuration()
+	case discord.GuildVoiceChannel:
+		backupChannel.NSFW = ch.NSFW()
+		backupChannel.RateLimitPerUser = ch.RateLimitPerUser()
+		backupChannel.Bitrate = ch.Bitrate()
+		backupChannel.UserLimit = ch.UserLimit
+		backupChannel.RTCRegion = ch.RTCRegion()
+		backupChannel.VideoQualityMode = ch.VideoQualityMode
+	case discord.GuildStageVoiceChannel:
+		backupChannel.NSFW = ch.NSFW()
+		backupChannel.RateLimitPerUser = ch.RateLimitPerUser()
+		backupChannel.Bitrate = ch.Bitrate()
+		backupChannel.RTCRegion = ch.RTCRegion()
+		backupChannel.VideoQualityMode = ch.VideoQualityMode
+	case discord.GuildForumChannel:
+		backupChannel.Topic = ch.Topic
+		backupChannel.NSFW = ch.NSFW
+		backupChannel.RateLimitPerUser = ch.RateLimitPerUser
+		backupChannel.DefaultThreadRateLimitPerUser = ch.DefaultThreadRateLimitPerUser
+		backupChannel.AvailableTags = ch.AvailableTags
+		backupChannel.DefaultReactionEmoji = ch.DefaultReactionEmoji
+		backupChannel.DefaultSortOrder = ch.DefaultSortOrder
+		backupChannel.DefaultForumLayout = ch.DefaultForumLayout
+	case discord.GuildMediaChannel:
+		backupChannel.Topic = ch.Topic
+		backupChannel.NSFW = ch.NSFW
+		backupChannel.RateLimitPerUser = ch.RateLimitPerUser
+		backupChannel.DefaultThreadRateLimitPerUser = ch.DefaultThreadRateLimitPerUser
+		backupChannel.AvailableTags = ch.AvailableTags
+		backupChannel.DefaultReactionEmoji = ch.DefaultReactionEmoji
+		backupChannel.DefaultSortOrder = ch.DefaultSortOrder
+	default:
+		// threads & unknown channel types are not part of a backup
+		return Channel{}, false
+	}
+	return backupChannel, true
+}
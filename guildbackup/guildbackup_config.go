@@ -0,0 +1,61 @@
+package guildbackup
+
+import (
+	"log/slog"
+)
+
+func defaultConfig() config {
+	return config{
+		Logger:        slog.Default(),
+		IncludeAssets: true,
+		Reconcile:     true,
+	}
+}
+
+type config struct {
+	Logger        *slog.Logger
+	IncludeAssets bool
+	Reconcile     bool
+	DeleteUnknown bool
+}
+
+// ConfigOpt is used to provide optional parameters to the guild backup client
+type ConfigOpt func(config *config)
+
+func (c *config) apply(opts []ConfigOpt) {
+	for _, opt := range opts {
+		opt(c)
+	}
+	c.Logger = c.Logger.With(slog.String("name", "guildbackup"))
+}
+
+// WithLogger sets the logger for the guild backup client
+func WithLogger(logger *slog.Logger) ConfigOpt {
+	return func(config *config) {
+		config.Logger = logger
+	}
+}
+
+// WithIncludeAssets sets whether the guild icon, role icons, emoji images & sticker files are downloaded and stored in the Backup.
+// Without assets emojis & stickers can't be restored. Defaults to true.
+func WithIncludeAssets(includeAssets bool) ConfigOpt {
+	return func(config *config) {
+		config.IncludeAssets = includeAssets
+	}
+}
+
+// WithReconcile sets whether existing roles, channels, emojis, stickers & auto moderation rules of the target guild
+// are matched by name and updated instead of creating duplicates. Defaults to true.
+func WithReconcile(reconcile bool) ConfigOpt {
+	return func(config *config) {
+		config.Reconcile = reconcile
+	}
+}
+
+// WithDeleteUnknown sets whether roles, channels, emojis, stickers & auto moderation rules of the target guild
+// which are not part of the Backup are deleted on restore. Defaults to false.
+func WithDeleteUnknown(deleteUnknown bool) ConfigOpt {
+	return func(config *config) {
+		config.DeleteUnknown = deleteUnknown
+	}
+}
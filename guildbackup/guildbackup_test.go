@@ -0,0 +1,295 @@
+package guildbackup
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/rest"
+)
+
+type restoreRest struct {
+	rest.Rest
+	nextID   snowflake.ID
+	existing struct {
+		channels []discord.GuildChannel
+		rules    []discord.AutoModerationRule
+	}
+	updatedChannels map[snowflake.ID]discord.ChannelUpdate
+	deletedRules    []snowflake.ID
+	roles           []discord.RoleCreate
+	channels        []discord.GuildChannelCreate
+	emojis          []discord.EmojiCreate
+	rules           []discord.AutoModerationRuleCreate
+	guild           *discord.GuildUpdate
+}
+
+func (r *restoreRest) id() snowflake.ID {
+	r.nextID++
+	return r.nextID
+}
+
+func (r *restoreRest) GetGuild(guildID snowflake.ID, _ bool, _ ...rest.RequestOpt) (*discord.RestGuild, error) {
+	return &discord.RestGuild{
+		Guild: discord.Guild{ID: guildID},
+		Roles: []discord.Role{{ID: guildID, Name: "@everyone"}},
+	}, nil
+}
+
+func (r *restoreRest) GetGuildChannels(_ snowflake.ID, _ ...rest.RequestOpt) ([]discord.GuildChannel, error) {
+	return r.existing.channels, nil
+}
+
+func (r *restoreRest) GetAutoModerationRules(_ snowflake.ID, _ ...rest.RequestOpt) ([]discord.AutoModerationRule, error) {
+	return r.existing.rules, nil
+}
+
+func (r *restoreRest) UpdateChannel(channelID snowflake.ID, channelUpdate discord.ChannelUpdate, _ ...rest.RequestOpt) (discord.Channel, error) {
+	r.updatedChannels[channelID] = channelUpdate
+	return nil, nil
+}
+
+func (r *restoreRest) DeleteAutoModerationRule(_ snowflake.ID, ruleID snowflake.ID, _ ...rest.RequestOpt) error {
+	r.deletedRules = append(r.deletedRules, ruleID)
+	return nil
+}
+
+func (r *restoreRest) UpdateRole(_ snowflake.ID, roleID snowflake.ID, _ discord.RoleUpdate, _ ...rest.RequestOpt) (*discord.Role, error) {
+	return &discord.Role{ID: roleID}, nil
+}
+
+func (r *restoreRest) CreateRole(_ snowflake.ID, roleCreate discord.RoleCreate, _ ...rest.RequestOpt) (*discord.Role, error) {
+	r.roles = append(r.roles, roleCreate)
+	return &discord.Role{ID: r.id(), Name: roleCreate.Name}, nil
+}
+
+func (r *restoreRest) UpdateRolePositions(_ snowflake.ID, _ []discord.RolePositionUpdate, _ ...rest.RequestOpt) ([]discord.Role, error) {
+	return nil, nil
+}
+
+func (r *restoreRest) CreateGuildChannel(guildID snowflake.ID, channelCreate discord.GuildChannelCreate, _ ...rest.RequestOpt) (discord.GuildChannel, error) {
+	r.channels = append(r.channels, channelCreate)
+	var channel discord.GuildCategoryChannel
+	if err := channel.UnmarshalJSON([]byte(`{"id":"` + r.id().String() + `","guild_id":"` + guildID.String() + `","type":4}`)); err != nil {
+		return nil, err
+	}
+	return channel, nil
+}
+
+func (r *restoreRest) UpdateChannelPositions(_ snowflake.ID, _ []discord.GuildChannelPositionUpdate, _ ...rest.RequestOpt) error {
+	return nil
+}
+
+func (r *restoreRest) CreateEmoji(_ snowflake.ID, emojiCreate discord.EmojiCreate, _ ...rest.RequestOpt) (*discord.Emoji, error) {
+	r.emojis = append(r.emojis, emojiCreate)
+	return &discord.Emoji{ID: r.id(), Name: emojiCreate.Name}, nil
+}
+
+func (r *restoreRest) UpdateGuild(_ snowflake.ID, guildUpdate discord.GuildUpdate, _ ...rest.RequestOpt) (*discord.RestGuild, error) {
+	r.guild = &guildUpdate
+	return &discord.RestGuild{}, nil
+}
+
+func (r *restoreRest) CreateAutoModerationRule(_ snowflake.ID, ruleCreate discord.AutoModerationRuleCreate, _ ...rest.RequestOpt) (*discord.AutoModerationRule, error) {
+	r.rules = append(r.rules, ruleCreate)
+	return &discord.AutoModerationRule{ID: r.id()}, nil
+}
+
+func TestClient_Restore(t *testing.T) {
+	t.Parallel()
+
+	categoryID := snowflake.ID(20)
+	backup := &Backup{
+		Version: Version,
+		GuildID: 1,
+		Guild: Guild{
+			Name:            "test",
+			SystemChannelID: &categoryID,
+		},
+		Roles: []Role{
+			{ID: 1, Name: "@everyone", Permissions: discord.PermissionViewChannel},
+			{ID: 10, Name: "mod", Position: 1, Permissions: discord.PermissionKickMembers},
+		},
+		Channels: []Channel{
+			{
+				ID:       21,
+				Type:     discord.ChannelTypeGuildText,
+				Name:     "general",
+				ParentID: &categoryID,
+				PermissionOverwrites: []PermissionOverwrite{
+					{ID: 10, Type: discord.PermissionOverwriteTypeRole, Allow: discord.PermissionManageMessages},
+					{ID: 99, Type: discord.PermissionOverwriteTypeRole, Allow: discord.PermissionManageMessages},
+					{ID: 5, Type: discord.PermissionOverwriteTypeMember, Deny: discord.PermissionSendMessages},
+				},
+			},
+			{ID: categoryID, Type: discord.ChannelTypeGuildCategory, Name: "text"},
+		},
+		AutoModerationRules: []discord.AutoModerationRule{
+			{ID: 30, Name: "spam", TriggerType: discord.AutoModerationTriggerTypeSpam, ExemptRoles: []snowflake.ID{10}, ExemptChannels: []snowflake.ID{21}},
+		},
+	}
+
+	r := &restoreRest{nextID: 1000}
+	result, err := New(r).Restore(context.Background(), 2, backup)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[snowflake.ID]snowflake.ID{1: 2, 10: 1001, 20: 1002, 21: 1003, 30: 1004}, result.IDs)
+	assert.Equal(t, 4, result.Created)
+
+	require.Len(t, r.channels, 2)
+	assert.IsType(t, discord.GuildCategoryChannelCreate{}, r.channels[0])
+	text := r.channels[1].(discord.GuildTextChannelCreate)
+	assert.Equal(t, snowflake.ID(1002), text.ParentID)
+	assert.Equal(t, []discord.PermissionOverwrite{
+		discord.RolePermissionOverwrite{RoleID: 1001, Allow: discord.PermissionManageMessages},
+		discord.MemberPermissionOverwrite{UserID: 5, Deny: discord.PermissionSendMessages},
+	}, text.PermissionOverwrites)
+
+	require.Len(t, r.rules, 1)
+	assert.Equal(t, []snowflake.ID{1001}, r.rules[0].ExemptRoles)
+	assert.Equal(t, []snowflake.ID{1003}, r.rules[0].ExemptChannels)
+	assert.Equal(t, snowflake.ID(1002), *r.guild.SystemChannelID)
+}
+
+func TestClient_RestoreForumEmojis(t *testing.T) {
+	t.Parallel()
+
+	emojiID := snowflake.ID(40)
+	foreignEmojiID := snowflake.ID(41)
+	backup := &Backup{
+		Version: Version,
+		GuildID: 1,
+		Emojis: []Emoji{
+			{ID: emojiID, Name: "pog", Image: &Asset{ContentType: "image/png", Data: []byte{1}}},
+		},
+		Channels: []Channel{
+			{
+				ID:   20,
+				Type: discord.ChannelTypeGuildForum,
+				Name: "forum",
+				AvailableTags: []discord.ChannelTag{
+					{ID: 50, Name: "custom", EmojiID: &emojiID},
+					{ID: 51, Name: "foreign", EmojiID: &foreignEmojiID},
+				},
+				DefaultReactionEmoji: &discord.DefaultReactionEmoji{EmojiID: &emojiID},
+			},
+		},
+	}
+
+	r := &restoreRest{nextID: 1000}
+	result, err := New(r).Restore(context.Background(), 2, backup)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[snowflake.ID]snowflake.ID{1: 2, 40: 1001, 20: 1002}, result.IDs)
+	require.Len(t, r.emojis, 1)
+	require.Len(t, r.channels, 1)
+	forum := r.channels[0].(discord.GuildForumChannelCreate)
+	require.Len(t, forum.AvailableTags, 2)
+	assert.Equal(t, snowflake.ID(1001), *forum.AvailableTags[0].EmojiID)
+	// tags of new channels are created instead of referencing the tags of the source guild
+	assert.Zero(t, forum.AvailableTags[0].ID)
+	assert.Zero(t, forum.AvailableTags[1].ID)
+	assert.Equal(t, foreignEmojiID, *forum.AvailableTags[1].EmojiID)
+	assert.Equal(t, snowflake.ID(1001), *forum.DefaultReactionEmoji.EmojiID)
+
+	// the backup itself must not be modified
+	assert.Equal(t, emojiID, *backup.Channels[0].AvailableTags[0].EmojiID)
+	assert.Equal(t, emojiID, *backup.Channels[0].DefaultReactionEmoji.EmojiID)
+}
+
+func TestClient_RestoreReconcile(t *testing.T) {
+	t.Parallel()
+
+	var forum discord.GuildForumChannel
+	require.NoError(t, forum.UnmarshalJSON([]byte(`{"id":"100","guild_id":"2","type":15,"name":"forum","available_tags":[{"id":"101","name":"kept"},{"id":"102","name":"other"}]}`)))
+	alertChannelID := snowflake.ID(99)
+	backup := &Backup{
+		Version: Version,
+		GuildID: 1,
+		Channels: []Channel{
+			{
+				ID:   20,
+				Type: discord.ChannelTypeGuildForum,
+				Name: "forum",
+				AvailableTags: []discord.ChannelTag{
+					{ID: 50, Name: "kept"},
+					{ID: 51, Name: "new"},
+				},
+			},
+		},
+		AutoModerationRules: []discord.AutoModerationRule{
+			{
+				ID:          30,
+				Name:        "rule",
+				TriggerType: discord.AutoModerationTriggerTypeKeyword,
+				Actions: []discord.AutoModerationAction{
+					{Type: discord.AutoModerationActionTypeBlockMessage},
+					{Type: discord.AutoModerationActionTypeSendAlertMessage, Metadata: &discord.AutoModerationActionMetadata{ChannelID: alertChannelID}},
+				},
+			},
+		},
+	}
+
+	r := &restoreRest{nextID: 1000, updatedChannels: map[snowflake.ID]discord.ChannelUpdate{}}
+	r.existing.channels = []discord.GuildChannel{forum}
+	r.existing.rules = []discord.AutoModerationRule{
+		{ID: 200, Name: "rule", TriggerType: discord.AutoModerationTriggerTypeSpam},
+	}
+	result, err := New(r, WithDeleteUnknown(true)).Restore(context.Background(), 2, backup)
+	// the alert channel is not part of the backup
+	assert.ErrorContains(t, err, "unknown channel 99")
+
+	update := r.updatedChannels[100].(discord.GuildForumChannelUpdate)
+	assert.Equal(t, []discord.ChannelTag{{ID: 101, Name: "kept"}, {Name: "new"}}, *update.AvailableTags)
+
+	// the rule with the same name but another trigger type is replaced
+	require.Len(t, r.rules, 1)
+	assert.Equal(t, discord.AutoModerationTriggerTypeKeyword, r.rules[0].TriggerType)
+	assert.Equal(t, []discord.AutoModerationAction{{Type: discord.AutoModerationActionTypeBlockMessage}}, r.rules[0].Actions)
+	assert.Equal(t, []snowflake.ID{200}, r.deletedRules)
+	assert.Equal(t, snowflake.ID(1001), result.IDs[30])
+}
+
+func TestClient_RestoreUnsupportedVersion(t *testing.T) {
+	t.Parallel()
+
+	_, err := New(&restoreRest{}).Restore(context.Background(), 1, &Backup{Version: Version + 1})
+	assert.ErrorIs(t, err, ErrUnsupportedVersion)
+}
+
+type assetRest struct {
+	rest.Rest
+}
+
+func (r *assetRest) HTTPClient() *http.Client {
+	return http.DefaultClient
+}
+
+func TestClient_DownloadAssetTooLarge(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		if r.URL.Path == "/content-length" {
+			w.Header().Set("Content-Length", strconv.Itoa(maxAssetSize+1))
+		} else {
+			// no Content-Length, so the size is only known after reading the body
+			w.(http.Flusher).Flush()
+		}
+		_, _ = w.Write(make([]byte, maxAssetSize+1))
+	}))
+	defer srv.Close()
+
+	c := New(&assetRest{})
+	_, err := c.downloadAsset(context.Background(), srv.URL+"/content-length")
+	assert.ErrorContains(t, err, "exceeds the max size")
+	_, err = c.downloadAsset(context.Background(), srv.URL+"/chunked")
+	assert.ErrorContains(t, err, "exceeds the max size")
+}
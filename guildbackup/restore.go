@@ -0,0 +1,847 @@
+package guildbackup
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/disgoorg/omit"
+	"github.com/disgoorg/snowflake/v2"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/rest"
+)
+
+// ErrUnsupportedVersion is returned when restoring a Backup created by a newer version of this package
+var ErrUnsupportedVersion = errors.New("unsupported backup version")
+
+// RestoreResult holds the outcome of a restore
+type RestoreResult struct {
+	// IDs maps the IDs of the Backup to the IDs of the target guild
+	IDs     map[snowflake.ID]snowflake.ID
+	Created int
+	Updated int
+	Deleted int
+}
+
+// ID returns the ID in the target guild for the given ID of the Backup
+func (r RestoreResult) ID(id snowflake.ID) (snowflake.ID, bool) {
+	newID, ok := r.IDs[id]
+	return newID, ok
+}
+
+// Restore recreates the Backup in the target guild, which may be the source guild itself or any other guild.
+// Roles, channels, emojis, stickers & auto moderation rules which already exist in the target guild are matched by name and updated if reconciling is enabled.
+// Failures of single items don't stop the restore, instead all of them are returned together with the RestoreResult.
+func (c *Client) Restore(ctx context.Context, guildID snowflake.ID, backup *Backup) (*RestoreResult, error) {
+	if backup.Version > Version {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, backup.Version)
+	}
+	opts := []rest.RequestOpt{rest.WithCtx(ctx)}
+
+	guild, err := c.Rest.GetGuild(guildID, false, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guild: %w", err)
+	}
+	channels, err := c.Rest.GetGuildChannels(guildID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get channels: %w", err)
+	}
+	rules, err := c.Rest.GetAutoModerationRules(guildID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get auto moderation rules: %w", err)
+	}
+
+	r := &restorer{
+		client:  c,
+		opts:    opts,
+		guildID: guildID,
+		backup:  backup,
+		result: &RestoreResult{
+			// the @everyone role has the same ID as its guild
+			IDs: map[snowflake.ID]snowflake.ID{backup.GuildID: guildID},
+		},
+	}
+	steps := []func(){
+		func() { r.restoreRoles(guild.Roles) },
+		// emojis are restored before channels, so the emojis of forum tags & default reactions can be mapped
+		func() { r.restoreEmojis(guild.Emojis) },
+		func() { r.restoreChannels(channels) },
+		func() { r.restoreStickers(guild.Stickers) },
+		r.restoreGuild,
+		func() { r.restoreWelcomeScreen(guild.Guild) },
+		func() { r.restoreOnboarding(guild.Guild) },
+		func() { r.restoreAutoModerationRules(rules) },
+	}
+	for _, step := range steps {
+		if err = ctx.Err(); err != nil {
+			return r.result, errors.Join(append(r.errs, err)...)
+		}
+		step()
+	}
+	return r.result, errors.Join(r.errs...)
+}
+
+type restorer struct {
+	client  *Client
+	opts    []rest.RequestOpt
+	guildID snowflake.ID
+	backup  *Backup
+	result  *RestoreResult
+	errs    []error
+}
+
+func (r *restorer) fail(err error, format string, a ...any) {
+	err = fmt.Errorf(format+": %w", append(a, err)...)
+	r.client.config.Logger.Error("failed to restore backup item", slog.Any("err", err))
+	r.errs = append(r.errs, err)
+}
+
+// id returns the ID in the target guild for the given ID of the Backup
+func (r *restorer) id(id snowflake.ID) (snowflake.ID, bool) {
+	return r.result.ID(id)
+}
+
+// idPtr maps the given ID of the Backup or returns nil if it is nil or unknown
+func (r *restorer) idPtr(id *snowflake.ID) *snowflake.ID {
+	if id == nil {
+		return nil
+	}
+	if newID, ok := r.id(*id); ok {
+		return &newID
+	}
+	return nil
+}
+
+// ids maps all given IDs of the Backup and drops unknown ones
+func (r *restorer) ids(ids []snowflake.ID) []snowflake.ID {
+	newIDs := make([]snowflake.ID, 0, len(ids))
+	for _, id := range ids {
+		if newID, ok := r.id(id); ok {
+			newIDs = append(newIDs, newID)
+		}
+	}
+	return newIDs
+}
+
+// emojiID maps a custom emoji ID of the Backup. Unknown emojis are kept as they might be from another guild.
+func (r *restorer) emojiID(id *snowflake.ID) *snowflake.ID {
+	if id == nil {
+		return nil
+	}
+	if newID, ok := r.id(*id); ok {
+		return &newID
+	}
+	return id
+}
+
+func (r *restorer) deleteUnknown() bool {
+	return r.client.config.DeleteUnknown
+}
+
+// matcher hands out existing items by key, each of them only once. A disabled matcher never matches.
+type matcher[T any] struct {
+	items   map[string][]T
+	enabled bool
+}
+
+func newMatcher[T any](items []T, enabled bool, key func(T) (string, bool)) *matcher[T] {
+	m := &matcher[T]{items: map[string][]T{}, enabled: enabled}
+	for _, item := range items {
+		if k, ok := key(item); ok {
+			m.items[k] = append(m.items[k], item)
+		}
+	}
+	return m
+}
+
+func (m *matcher[T]) take(key string) (T, bool) {
+	items := m.items[key]
+	if !m.enabled || len(items) == 0 {
+		var zero T
+		return zero, false
+	}
+	m.items[key] = items[1:]
+	return items[0], true
+}
+
+func (m *matcher[T]) remaining() []T {
+	var items []T
+	for _, v := range m.items {
+		items = append(items, v...)
+	}
+	return items
+}
+
+func (r *restorer) restoreRoles(existing []discord.Role) {
+	roles := newMatcher(existing, r.client.config.Reconcile, func(role discord.Role) (string, bool) {
+		return role.Name, !role.Managed && role.ID != r.guildID
+	})
+
+	var positions []discord.RolePositionUpdate
+	for _, role := range r.backup.Roles {
+		if role.ID == r.backup.GuildID {
+			if _, err := r.client.Rest.UpdateRole(r.guildID, r.guildID, discord.RoleUpdate{Permissions: &role.Permissions}, r.opts...); err != nil {
+				r.fail(err, "failed to update @everyone role")
+				continue
+			}
+			r.result.Updated++
+			continue
+		}
+
+		var icon *discord.Icon
+		if role.Icon != nil {
+			icon = role.Icon.icon()
+		}
+		if match, ok := roles.take(role.Name); ok {
+			update := discord.RoleUpdate{
+				Name:        &role.Name,
+				Permissions: &role.Permissions,
+				Colors:      omit.NewPtr(role.Colors),
+				Hoist:       &role.Hoist,
+				Emoji:       role.Emoji,
+				Mentionable: &role.Mentionable,
+			}
+			if icon != nil {
+				update.Icon = omit.New(icon)
+			}
+			if _, err := r.client.Rest.UpdateRole(r.guildID, match.ID, update, r.opts...); err != nil {
+				r.fail(err, "failed to update role %q", role.Name)
+				continue
+			}
+			r.result.IDs[role.ID] = match.ID
+			r.result.Updated++
+		} else {
+			create := discord.RoleCreate{
+				Name:        role.Name,
+				Permissions: &role.Permissions,
+				Colors:      role.Colors,
+				Hoist:       role.Hoist,
+				Icon:        icon,
+				Mentionable: role.Mentionable,
+			}
+			if role.Emoji != nil {
+				create.Emoji = *role.Emoji
+			}
+			newRole, err := r.client.Rest.CreateRole(r.guildID, create, r.opts...)
+			if err != nil {
+				r.fail(err, "failed to create role %q", role.Name)
+				continue
+			}
+			r.result.IDs[role.ID] = newRole.ID
+			r.result.Created++
+		}
+		positions = append(positions, discord.RolePositionUpdate{
+			ID:       r.result.IDs[role.ID],
+			Position: omit.Ptr(role.Position),
+		})
+	}
+
+	if r.deleteUnknown() {
+		for _, role := range roles.remaining() {
+			if err := r.client.Rest.DeleteRole(r.guildID, role.ID, r.opts...); err != nil {
+				r.fail(err, "failed to delete role %q", role.Name)
+				continue
+			}
+			r.result.Deleted++
+		}
+	}
+
+	if len(positions) > 0 {
+		if _, err := r.client.Rest.UpdateRolePositions(r.guildID, positions, r.opts...); err != nil {
+			r.fail(err, "failed to update role positions")
+		}
+	}
+}
+
+func (r *restorer) restoreChannels(existing []discord.GuildChannel) {
+	channelKey := func(channelType discord.ChannelType, name string) string {
+		return fmt.Sprintf("%d:%s", channelType, name)
+	}
+	channels := newMatcher(existing, r.client.config.Reconcile, func(channel discord.GuildChannel) (string, bool) {
+		return channelKey(channel.Type(), channel.Name()), true
+	})
+
+	// categories are restored first, so the parent IDs of all other channels can be mapped
+	backupChannels := slices.Clone(r.backup.Channels)
+	slices.SortStableFunc(backupChannels, func(a, b Channel) int {
+		return boolInt(a.Type != discord.ChannelTypeGuildCategory) - boolInt(b.Type != discord.ChannelTypeGuildCategory)
+	})
+
+	var positions []discord.GuildChannelPositionUpdate
+	for _, channel := range backupChannels {
+		r.channelEmojis(&channel)
+		parentID := r.idPtr(channel.ParentID)
+		overwrites := r.permissionOverwrites(channel.PermissionOverwrites)
+
+		if match, ok := channels.take(channelKey(channel.Type, channel.Name)); ok {
+			channelTagIDs(channel.AvailableTags, existingChannelTags(match))
+			if _, err := r.client.Rest.UpdateChannel(match.ID(), channelUpdate(channel, parentID, overwrites), r.opts...); err != nil {
+				r.fail(err, "failed to update channel %q", channel.Name)
+				continue
+			}
+			r.result.IDs[channel.ID] = match.ID()
+			r.result.Updated++
+		} else {
+			channelTagIDs(channel.AvailableTags, nil)
+			create := channelCreate(channel, parentID, overwrites)
+			if create == nil {
+				continue
+			}
+			newChannel, err := r.client.Rest.CreateGuildChannel(r.guildID, create, r.opts...)
+			if err != nil {
+				r.fail(err, "failed to create channel %q", channel.Name)
+				continue
+			}
+			r.result.IDs[channel.ID] = newChannel.ID()
+			r.result.Created++
+		}
+		positions = append(positions, discord.GuildChannelPositionUpdate{
+			ID:       r.result.IDs[channel.ID],
+			Position: omit.NewPtr(channel.Position),
+			ParentID: parentID,
+		})
+	}
+
+	if r.deleteUnknown() {
+		for _, channel := range channels.remaining() {
+			if err := r.client.Rest.DeleteChannel(channel.ID(), r.opts...); err != nil {
+				r.fail(err, "failed to delete channel %q", channel.Name())
+				continue
+			}
+			r.result.Deleted++
+		}
+	}
+
+	if len(positions) > 0 {
+		if err := r.client.Rest.UpdateChannelPositions(r.guildID, positions, r.opts...); err != nil {
+			r.fail(err, "failed to update channel positions")
+		}
+	}
+}
+
+// channelEmojis maps the emojis of the available tags & the default reaction emoji of forum & media channels
+func (r *restorer) channelEmojis(channel *Channel) {
+	if len(channel.AvailableTags) > 0 {
+		tags := slices.Clone(channel.AvailableTags)
+		for i := range tags {
+			tags[i].EmojiID = r.emojiID(tags[i].EmojiID)
+		}
+		channel.AvailableTags = tags
+	}
+	if channel.DefaultReactionEmoji != nil {
+		defaultReactionEmoji := *channel.DefaultReactionEmoji
+		defaultReactionEmoji.EmojiID = r.emojiID(defaultReactionEmoji.EmojiID)
+		channel.DefaultReactionEmoji = &defaultReactionEmoji
+	}
+}
+
+// channelTagIDs sets the IDs of the tags to the IDs of the existing tags with the same name.
+// Tags without a matching existing tag get no ID, so they are created.
+func channelTagIDs(tags []discord.ChannelTag, existing []discord.ChannelTag) {
+	for i := range tags {
+		tags[i].ID = 0
+		for _, tag := range existing {
+			if tag.Name == tags[i].Name {
+				tags[i].ID = tag.ID
+				break
+			}
+		}
+	}
+}
+
+func existingChannelTags(channel discord.GuildChannel) []discord.ChannelTag {
+	switch c := channel.(type) {
+	case discord.GuildForumChannel:
+		return c.AvailableTags
+	case discord.GuildMediaChannel:
+		return c.AvailableTags
+	}
+	return nil
+}
+
+// permissionOverwrites maps the role IDs of the PermissionOverwrite(s) and drops overwrites of unknown roles
+func (r *restorer) permissionOverwrites(overwrites []PermissionOverwrite) []discord.PermissionOverwrite {
+	newOverwrites := make([]discord.PermissionOverwrite, 0, len(overwrites))
+	for _, overwrite := range overwrites {
+		switch overwrite.Type {
+		case discord.PermissionOverwriteTypeRole:
+			roleID, ok := r.id(overwrite.ID)
+			if !ok {
+				continue
+			}
+			newOverwrites = append(newOverwrites, discord.RolePermissionOverwrite{RoleID: roleID, Allow: overwrite.Allow, Deny: overwrite.Deny})
+		case discord.PermissionOverwriteTypeMember:
+			newOverwrites = append(newOverwrites, discord.MemberPermissionOverwrite{UserID: overwrite.ID, Allow: overwrite.Allow, Deny: overwrite.Deny})
+		}
+	}
+	return newOverwrites
+}
+
+func (r *restorer) restoreEmojis(existing []discord.Emoji) {
+	emojis := newMatcher(existing, r.client.config.Reconcile, func(emoji discord.Emoji) (string, bool) {
+		return emoji.Name, !emoji.Managed
+	})
+
+	for _, emoji := range r.backup.Emojis {
+		roles := r.ids(emoji.Roles)
+		if match, ok := emojis.take(emoji.Name); ok {
+			if _, err := r.client.Rest.UpdateEmoji(r.guildID, match.ID, discord.EmojiUpdate{Roles: &roles}, r.opts...); err != nil {
+				r.fail(err, "failed to update emoji %q", emoji.Name)
+				continue
+			}
+			r.result.IDs[emoji.ID] = match.ID
+			r.result.Updated++
+			continue
+		}
+		if emoji.Image == nil {
+			r.fail(errors.New("image not included in backup"), "failed to create emoji %q", emoji.Name)
+			continue
+		}
+		newEmoji, err := r.client.Rest.CreateEmoji(r.guildID, discord.EmojiCreate{
+			Name:  emoji.Name,
+			Image: *emoji.Image.icon(),
+			Roles: roles,
+		}, r.opts...)
+		if err != nil {
+			r.fail(err, "failed to create emoji %q", emoji.Name)
+			continue
+		}
+		r.result.IDs[emoji.ID] = newEmoji.ID
+		r.result.Created++
+	}
+
+	if r.deleteUnknown() {
+		for _, emoji := range emojis.remaining() {
+			if err := r.client.Rest.DeleteEmoji(r.guildID, emoji.ID, r.opts...); err != nil {
+				r.fail(err, "failed to delete emoji %q", emoji.Name)
+				continue
+			}
+			r.result.Deleted++
+		}
+	}
+}
+
+func (r *restorer) restoreStickers(existing []discord.Sticker) {
+	stickers := newMatcher(existing, r.client.config.Reconcile, func(sticker discord.Sticker) (string, bool) {
+		return sticker.Name, true
+	})
+
+	for _, sticker := range r.backup.Stickers {
+		if match, ok := stickers.take(sticker.Name); ok {
+			if _, err := r.client.Rest.UpdateSticker(r.guildID, match.ID, discord.StickerUpdate{
+				Description: &sticker.Description,
+				Tags:        &sticker.Tags,
+			}, r.opts...); err != nil {
+				r.fail(err, "failed to update sticker %q", sticker.Name)
+				continue
+			}
+			r.result.IDs[sticker.ID] = match.ID
+			r.result.Updated++
+			continue
+		}
+		if sticker.File == nil {
+			r.fail(errors.New("file not included in backup"), "failed to create sticker %q", sticker.Name)
+			continue
+		}
+		newSticker, err := r.client.Rest.CreateSticker(r.guildID, discord.StickerCreate{
+			Name:        sticker.Name,
+			Description: sticker.Description,
+			Tags:        sticker.Tags,
+			File:        discord.NewFile(sticker.Name+"."+stickerFileFormat(sticker.FormatType).String(), "", bytes.NewReader(sticker.File.Data)),
+		}, r.opts...)
+		if err != nil {
+			r.fail(err, "failed to create sticker %q", sticker.Name)
+			continue
+		}
+		r.result.IDs[sticker.ID] = newSticker.ID
+		r.result.Created++
+	}
+
+	if r.deleteUnknown() {
+		for _, sticker := range stickers.remaining() {
+			if err := r.client.Rest.DeleteSticker(r.guildID, sticker.ID, r.opts...); err != nil {
+				r.fail(err, "failed to delete sticker %q", sticker.Name)
+				continue
+			}
+			r.result.Deleted++
+		}
+	}
+}
+
+func (r *restorer) restoreGuild() {
+	guild := r.backup.Guild
+	update := discord.GuildUpdate{
+		Name:                        &guild.Name,
+		VerificationLevel:           omit.NewPtr(guild.VerificationLevel),
+		DefaultMessageNotifications: omit.NewPtr(guild.DefaultMessageNotifications),
+		ExplicitContentFilter:       omit.NewPtr(guild.ExplicitContentFilter),
+		AFKChannelID:                r.idPtr(guild.AFKChannelID),
+		AFKTimeout:                  &guild.AFKTimeout,
+		SystemChannelID:             r.idPtr(guild.SystemChannelID),
+		SystemChannelFlags:          &guild.SystemChannelFlags,
+		RulesChannelID:              r.idPtr(guild.RulesChannelID),
+		PublicUpdatesChannelID:      r.idPtr(guild.PublicUpdatesChannelID),
+		SafetyAlertsChannelID:       r.idPtr(guild.SafetyAlertsChannelID),
+		PreferredLocale:             &guild.PreferredLocale,
+		Description:                 guild.Description,
+		PremiumProgressBarEnabled:   &guild.PremiumProgressBarEnabled,
+	}
+	if guild.Icon != nil {
+		update.Icon = omit.New(guild.Icon.icon())
+	}
+	if _, err := r.client.Rest.UpdateGuild(r.guildID, update, r.opts...); err != nil {
+		r.fail(err, "failed to update guild")
+		return
+	}
+	r.result.Updated++
+}
+
+func (r *restorer) restoreWelcomeScreen(guild discord.Guild) {
+	welcomeScreen := r.backup.WelcomeScreen
+	if welcomeScreen == nil || !slices.Contains(guild.Features, discord.GuildFeatureCommunity) {
+		return
+	}
+
+	welcomeChannels := make([]discord.GuildWelcomeChannel, 0, len(welcomeScreen.WelcomeChannels))
+	for _, welcomeChannel := range welcomeScreen.WelcomeChannels {
+		channelID, ok := r.id(welcomeChannel.ChannelID)
+		if !ok {
+			continue
+		}
+		welcomeChannel.ChannelID = channelID
+		welcomeChannel.EmojiID = r.emojiID(welcomeChannel.EmojiID)
+		welcomeChannels = append(welcomeChannels, welcomeChannel)
+	}
+	if _, err := r.client.Rest.UpdateGuildWelcomeScreen(r.guildID, discord.GuildWelcomeScreenUpdate{
+		Enabled:         &welcomeScreen.Enabled,
+		WelcomeChannels: &welcomeChannels,
+		Description:     welcomeScreen.Description,
+	}, r.opts...); err != nil {
+		r.fail(err, "failed to update welcome screen")
+		return
+	}
+	r.result.Updated++
+}
+
+func (r *restorer) restoreOnboarding(guild discord.Guild) {
+	onboarding := r.backup.Onboarding
+	if onboarding == nil || !slices.Contains(guild.Features, discord.GuildFeatureCommunity) {
+		return
+	}
+
+	prompts := make([]discord.GuildOnboardingPrompt, len(onboarding.Prompts))
+	for i, prompt := range onboarding.Prompts {
+		options := make([]discord.GuildOnboardingPromptOption, len(prompt.Options))
+		for j, option := range prompt.Options {
+			option.ChannelIDs = r.ids(option.ChannelIDs)
+			option.RoleIDs = r.ids(option.RoleIDs)
+			option.Emoji.ID = r.emojiID(option.Emoji.ID)
+			options[j] = option
+		}
+		prompt.Options = options
+		prompts[i] = prompt
+	}
+	defaultChannelIDs := r.ids(onboarding.DefaultChannelIDs)
+
+	if _, err := r.client.Rest.UpdateGuildOnboarding(r.guildID, discord.GuildOnboardingUpdate{
+		Prompts:           &prompts,
+		DefaultChannelIDs: &defaultChannelIDs,
+		Enabled:           &onboarding.Enabled,
+		Mode:              &onboarding.Mode,
+	}, r.opts...); err != nil {
+		r.fail(err, "failed to update onboarding")
+		return
+	}
+	r.result.Updated++
+}
+
+func (r *restorer) restoreAutoModerationRules(existing []discord.AutoModerationRule) {
+	// rules with another trigger type can't be updated to the trigger type of the backup, so they don't match
+	ruleKey := func(triggerType discord.AutoModerationTriggerType, name string) string {
+		return fmt.Sprintf("%d:%s", triggerType, name)
+	}
+	rules := newMatcher(existing, r.client.config.Reconcile, func(rule discord.AutoModerationRule) (string, bool) {
+		return ruleKey(rule.TriggerType, rule.Name), true
+	})
+
+	for _, rule := range r.backup.AutoModerationRules {
+		actions := make([]discord.AutoModerationAction, 0, len(rule.Actions))
+		for _, action := range rule.Actions {
+			if action.Metadata != nil {
+				metadata := *action.Metadata
+				if metadata.ChannelID != 0 {
+					channelID, ok := r.id(metadata.ChannelID)
+					if !ok {
+						r.fail(fmt.Errorf("unknown channel %d", metadata.ChannelID), "failed to restore alert action of auto moderation rule %q", rule.Name)
+						continue
+					}
+					metadata.ChannelID = channelID
+				}
+				action.Metadata = &metadata
+			}
+			actions = append(actions, action)
+		}
+		exemptRoles := r.ids(rule.ExemptRoles)
+		exemptChannels := r.ids(rule.ExemptChannels)
+
+		if match, ok := rules.take(ruleKey(rule.TriggerType, rule.Name)); ok {
+			if _, err := r.client.Rest.UpdateAutoModerationRule(r.guildID, match.ID, discord.AutoModerationRuleUpdate{
+				Name:            &rule.Name,
+				EventType:       &rule.EventType,
+				TriggerMetadata: &rule.TriggerMetadata,
+				Actions:         &actions,
+				Enabled:         &rule.Enabled,
+				ExemptRoles:     &exemptRoles,
+				ExemptChannels:  &exemptChannels,
+			}, r.opts...); err != nil {
+				r.fail(err, "failed to update auto moderation rule %q", rule.Name)
+				continue
+			}
+			r.result.IDs[rule.ID] = match.ID
+			r.result.Updated++
+			continue
+		}
+		newRule, err := r.client.Rest.CreateAutoModerationRule(r.guildID, discord.AutoModerationRuleCreate{
+			Name:            rule.Name,
+			EventType:       rule.EventType,
+			TriggerType:     rule.TriggerType,
+			TriggerMetadata: &rule.TriggerMetadata,
+			Actions:         actions,
+			Enabled:         &rule.Enabled,
+			ExemptRoles:     exemptRoles,
+			ExemptChannels:  exemptChannels,
+		}, r.opts...)
+		if err != nil {
+			r.fail(err, "failed to create auto moderation rule %q", rule.Name)
+			continue
+		}
+		r.result.IDs[rule.ID] = newRule.ID
+		r.result.Created++
+	}
+
+	if r.deleteUnknown() {
+		for _, rule := range rules.remaining() {
+			if err := r.client.Rest.DeleteAutoModerationRule(r.guildID, rule.ID, r.opts...); err != nil {
+				r.fail(err, "failed to delete auto moderation rule %q", rule.Name)
+				continue
+			}
+			r.result.Deleted++
+		}
+	}
+}
+
+func (a Asset) icon() *discord.Icon {
+	return discord.NewIconRaw(discord.IconType(a.ContentType), a.Data)
+}
+
+func stickerFileFormat(formatType discord.StickerFormatType) discord.FileFormat {
+	switch formatType {
+	case discord.StickerFormatTypeLottie:
+		return discord.FileFormatLottie
+	case discord.StickerFormatTypeGIF:
+		return discord.FileFormatGIF
+	default:
+		return discord.FileFormatPNG
+	}
+}
+
+func channelCreate(channel Channel, parentID *snowflake.ID, overwrites []discord.PermissionOverwrite) discord.GuildChannelCreate {
+	var parent snowflake.ID
+	if parentID != nil {
+		parent = *parentID
+	}
+	var topic string
+	if channel.Topic != nil {
+		topic = *channel.Topic
+	}
+
+	switch channel.Type {
+	case discord.ChannelTypeGuildCategory:
+		return discord.GuildCategoryChannelCreate{
+			Name:                 channel.Name,
+			Position:             channel.Position,
+			PermissionOverwrites: overwrites,
+		}
+	case discord.ChannelTypeGuildText:
+		return discord.GuildTextChannelCreate{
+			Name:                          channel.Name,
+			Topic:                         topic,
+			RateLimitPerUser:              channel.RateLimitPerUser,
+			Position:                      channel.Position,
+			PermissionOverwrites:          overwrites,
+			ParentID:                      parent,
+			NSFW:                          channel.NSFW,
+			DefaultAutoArchiveDuration:    channel.DefaultAutoArchiveDuration,
+			DefaultThreadRateLimitPerUser: channel.DefaultThreadRateLimitPerUser,
+		}
+	case discord.ChannelTypeGuildNews:
+		return discord.GuildNewsChannelCreate{
+			Name:                          channel.Name,
+			Topic:                         topic,
+			RateLimitPerUser:              channel.RateLimitPerUser,
+			Position:                      channel.Position,
+			PermissionOverwrites:          overwrites,
+			ParentID:                      parent,
+			NSFW:                          channel.NSFW,
+			DefaultAutoArchiveDuration:    channel.DefaultAutoArchiveDuration,
+			DefaultThreadRateLimitPerUser: channel.DefaultThreadRateLimitPerUser,
+		}
+	case discord.ChannelTypeGuildVoice:
+		return discord.GuildVoiceChannelCreate{
+			Name:                 channel.Name,
+			Bitrate:              channel.Bitrate,
+			UserLimit:            channel.UserLimit,
+			RateLimitPerUser:     channel.RateLimitPerUser,
+			Position:             channel.Position,
+			PermissionOverwrites: overwrites,
+			ParentID:             parent,
+			NSFW:                 channel.NSFW,
+			RTCRegion:            channel.RTCRegion,
+			VideoQualityMode:     channel.VideoQualityMode,
+		}
+	case discord.ChannelTypeGuildStageVoice:
+		return discord.GuildStageVoiceChannelCreate{
+			Name:                 channel.Name,
+			Bitrate:              channel.Bitrate,
+			UserLimit:            channel.UserLimit,
+			RateLimitPerUser:     channel.RateLimitPerUser,
+			Position:             channel.Position,
+			PermissionOverwrites: overwrites,
+			ParentID:             parent,
+			NSFW:                 channel.NSFW,
+			RTCRegion:            channel.RTCRegion,
+			VideoQualityMode:     channel.VideoQualityMode,
+		}
+	case discord.ChannelTypeGuildForum:
+		create := discord.GuildForumChannelCreate{
+			Name:                          channel.Name,
+			Topic:                         topic,
+			Position:                      channel.Position,
+			PermissionOverwrites:          overwrites,
+			ParentID:                      parent,
+			RateLimitPerUser:              channel.RateLimitPerUser,
+			AvailableTags:                 channel.AvailableTags,
+			DefaultForumLayout:            channel.DefaultForumLayout,
+			DefaultThreadRateLimitPerUser: channel.DefaultThreadRateLimitPerUser,
+		}
+		if channel.DefaultReactionEmoji != nil {
+			create.DefaultReactionEmoji = *channel.DefaultReactionEmoji
+		}
+		if channel.DefaultSortOrder != nil {
+			create.DefaultSortOrder = *channel.DefaultSortOrder
+		}
+		return create
+	case discord.ChannelTypeGuildMedia:
+		create := discord.GuildMediaChannelCreate{
+			Name:                          channel.Name,
+			Topic:                         topic,
+			Position:                      channel.Position,
+			PermissionOverwrites:          overwrites,
+			ParentID:                      parent,
+			RateLimitPerUser:              channel.RateLimitPerUser,
+			AvailableTags:                 channel.AvailableTags,
+			DefaultThreadRateLimitPerUser: channel.DefaultThreadRateLimitPerUser,
+		}
+		if channel.DefaultReactionEmoji != nil {
+			create.DefaultReactionEmoji = *channel.DefaultReactionEmoji
+		}
+		if channel.DefaultSortOrder != nil {
+			create.DefaultSortOrder = *channel.DefaultSortOrder
+		}
+		return create
+	}
+	return nil
+}
+
+func channelUpdate(channel Channel, parentID *snowflake.ID, overwrites []discord.PermissionOverwrite) discord.ChannelUpdate {
+	switch channel.Type {
+	case discord.ChannelTypeGuildCategory:
+		return discord.GuildCategoryChannelUpdate{
+			Name:                 &channel.Name,
+			PermissionOverwrites: &overwrites,
+		}
+	case discord.ChannelTypeGuildText:
+		return discord.GuildTextChannelUpdate{
+			Name:                          &channel.Name,
+			Topic:                         channel.Topic,
+			NSFW:                          &channel.NSFW,
+			RateLimitPerUser:              &channel.RateLimitPerUser,
+			PermissionOverwrites:          &overwrites,
+			ParentID:                      parentID,
+			DefaultAutoArchiveDuration:    &channel.DefaultAutoArchiveDuration,
+			DefaultThreadRateLimitPerUser: &channel.DefaultThreadRateLimitPerUser,
+		}
+	case discord.ChannelTypeGuildNews:
+		defaultAutoArchiveDuration := int(channel.DefaultAutoArchiveDuration)
+		return discord.GuildNewsChannelUpdate{
+			Name:                       &channel.Name,
+			Topic:                      channel.Topic,
+			RateLimitPerUser:           &channel.RateLimitPerUser,
+			PermissionOverwrites:       &overwrites,
+			ParentID:                   parentID,
+			DefaultAutoArchiveDuration: &defaultAutoArchiveDuration,
+		}
+	case discord.ChannelTypeGuildVoice:
+		return discord.GuildVoiceChannelUpdate{
+			Name:                 &channel.Name,
+			RateLimitPerUser:     &channel.RateLimitPerUser,
+			Bitrate:              &channel.Bitrate,
+			UserLimit:            &channel.UserLimit,
+			PermissionOverwrites: &overwrites,
+			ParentID:             parentID,
+			RTCRegion:            &channel.RTCRegion,
+			NSFW:                 &channel.NSFW,
+			VideoQualityMode:     &channel.VideoQualityMode,
+		}
+	case discord.ChannelTypeGuildStageVoice:
+		return discord.GuildStageVoiceChannelUpdate{
+			Name:                 &channel.Name,
+			RateLimitPerUser:     &channel.RateLimitPerUser,
+			Bitrate:              &channel.Bitrate,
+			PermissionOverwrites: &overwrites,
+			ParentID:             parentID,
+			RTCRegion:            &channel.RTCRegion,
+			NSFW:                 &channel.NSFW,
+			VideoQualityMode:     &channel.VideoQualityMode,
+		}
+	case discord.ChannelTypeGuildForum:
+		return discord.GuildForumChannelUpdate{
+			Name:                          &channel.Name,
+			Topic:                         channel.Topic,
+			NSFW:                          &channel.NSFW,
+			PermissionOverwrites:          &overwrites,
+			ParentID:                      parentID,
+			RateLimitPerUser:              &channel.RateLimitPerUser,
+			AvailableTags:                 &channel.AvailableTags,
+			DefaultReactionEmoji:          omit.New(channel.DefaultReactionEmoji),
+			DefaultThreadRateLimitPerUser: &channel.DefaultThreadRateLimitPerUser,
+			DefaultSortOrder:              omit.New(channel.DefaultSortOrder),
+			DefaultForumLayout:            omit.NewPtr(channel.DefaultForumLayout),
+		}
+	case discord.ChannelTypeGuildMedia:
+		return discord.GuildMediaChannelUpdate{
+			Name:                          &channel.Name,
+			Topic:                         channel.Topic,
+			NSFW:                          &channel.NSFW,
+			PermissionOverwrites:          &overwrites,
+			ParentID:                      parentID,
+			RateLimitPerUser:              &channel.RateLimitPerUser,
+			AvailableTags:                 &channel.AvailableTags,
+			DefaultReactionEmoji:          omit.New(channel.DefaultReactionEmoji),
+			DefaultThreadRateLimitPerUser: &channel.DefaultThreadRateLimitPerUser,
+			DefaultSortOrder:              omit.New(channel.DefaultSortOrder),
+		}
+	}
+	return nil
+}
+
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -20,14 +20,15 @@ func GoDefer(interactionType discord.InteractionType, updateMessage bool, epheme
 }
 
 // GoErr is a middleware that runs the next handler in a goroutine and lets you handle the error which may occur.
+// The goroutine is started with bot.Client.Go, so bot.Client.Close waits for it to finish.
 func GoErr(h handler.ErrorHandler) handler.Middleware {
 	return func(next handler.Handler) handler.Handler {
 		return func(event *handler.InteractionEvent) error {
-			go func() {
+			event.Client().Go(func() {
 				if err := next(event); err != nil {
 					h(event, err)
 				}
-			}()
+			})
 			return nil
 		}
 	}
@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"github.com/disgoorg/disgo/bot"
+)
+
+// RouteModule is a bot.Module which registers routes on a Router.
+type RouteModule interface {
+	bot.Module
+	Routes(r Router)
+}
+
+// Modules registers the routes of all bot.Module(s) implementing RouteModule in their own group.
+// The routes of a bot.Module return bot.ErrModuleDisabled for interactions of guilds the bot.Module is disabled in.
+func (r *Mux) Modules(modules bot.ModuleManager) {
+	for _, module := range modules.Modules() {
+		rm, ok := module.(RouteModule)
+		if !ok {
+			continue
+		}
+		r.Group(func(r Router) {
+			r.Use(moduleMiddleware(modules, rm.Name()))
+			rm.Routes(r)
+		})
+	}
+}
+
+func moduleMiddleware(modules bot.ModuleManager, name string) Middleware {
+	return func(next Handler) Handler {
+		return func(e *InteractionEvent) error {
+			if guildID := e.GuildID(); guildID != nil && !modules.Enabled(name, *guildID) {
+				return bot.ErrModuleDisabled
+			}
+			return next(e)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+)
+
+type testModule struct {
+	name   string
+	routes func(r Router)
+}
+
+func (m *testModule) Name() string                  { return m.name }
+func (m *testModule) Init(_ *bot.Client) error      { return nil }
+func (m *testModule) Start(_ context.Context) error { return nil }
+func (m *testModule) Stop(_ context.Context) error  { return nil }
+
+type testRouteModule struct {
+	testModule
+}
+
+func (m *testRouteModule) Routes(r Router) { m.routes(r) }
+
+type testModuleManager struct {
+	bot.ModuleManager
+	modules  []bot.Module
+	disabled map[string]snowflake.ID
+}
+
+func (m *testModuleManager) Modules() []bot.Module { return m.modules }
+
+func (m *testModuleManager) Enabled(name string, guildID snowflake.ID) bool {
+	return m.disabled[name] != guildID
+}
+
+func TestMux_Modules(t *testing.T) {
+	t.Parallel()
+
+	slashData, err := os.ReadFile("testdata/command/slash_command.json")
+	require.NoError(t, err)
+	interaction, err := discord.UnmarshalInteraction(slashData)
+	require.NoError(t, err)
+	guildID := *interaction.GuildID()
+
+	var calls []string
+	route := func(name string) func(r Router) {
+		return func(r Router) {
+			r.Use(func(next Handler) Handler {
+				return func(e *InteractionEvent) error {
+					calls = append(calls, name+" middleware")
+					return next(e)
+				}
+			})
+			r.Command("/"+name, func(e *CommandEvent) error {
+				calls = append(calls, name)
+				return nil
+			})
+		}
+	}
+	modules := &testModuleManager{
+		modules: []bot.Module{
+			&testRouteModule{testModule{name: "foo", routes: route("foo")}},
+			&testRouteModule{testModule{name: "bar", routes: route("bar")}},
+			// modules without routes are skipped
+			&testModule{name: "baz"},
+		},
+		disabled: map[string]snowflake.ID{},
+	}
+
+	mux := New()
+	mux.Modules(modules)
+	var errs []error
+	mux.Error(func(e *InteractionEvent, err error) {
+		errs = append(errs, err)
+	})
+	handle := func() {
+		mux.OnEvent(&events.InteractionCreate{
+			GenericEvent: events.NewGenericEvent(nil, 0, 0),
+			Interaction:  interaction,
+			Respond:      NewRecorder().Respond,
+		})
+	}
+
+	handle()
+	// the middlewares of a module only apply to its own routes
+	assert.Equal(t, []string{"foo middleware", "foo"}, calls)
+	assert.Empty(t, errs)
+
+	calls = nil
+	modules.disabled["foo"] = guildID
+	handle()
+	assert.Empty(t, calls)
+	require.Len(t, errs, 1)
+	assert.ErrorIs(t, errs[0], bot.ErrModuleDisabled)
+}
+
+func TestModuleMiddleware(t *testing.T) {
+	t.Parallel()
+
+	slashData, err := os.ReadFile("testdata/command/slash_command.json")
+	require.NoError(t, err)
+	event := func(guildID string) *InteractionEvent {
+		data := strings.Replace(string(slashData), `"guild_id": "290926798626357999",`, guildID, 1)
+		interaction, err := discord.UnmarshalInteraction([]byte(data))
+		require.NoError(t, err)
+		return &InteractionEvent{InteractionCreate: &events.InteractionCreate{Interaction: interaction}}
+	}
+
+	modules := &testModuleManager{disabled: map[string]snowflake.ID{"foo": 1}}
+	var called int
+	handler := func(e *InteractionEvent) error {
+		called++
+		return nil
+	}
+
+	assert.ErrorIs(t, moduleMiddleware(modules, "foo")(handler)(event(`"guild_id": "1",`)), bot.ErrModuleDisabled)
+	assert.NoError(t, moduleMiddleware(modules, "foo")(handler)(event(`"guild_id": "2",`)))
+	assert.NoError(t, moduleMiddleware(modules, "bar")(handler)(event(`"guild_id": "1",`)))
+	// interactions outside of guilds are always handled
+	assert.NoError(t, moduleMiddleware(modules, "foo")(handler)(event("")))
+	assert.Equal(t, 3, called)
+}
@@ -164,6 +164,10 @@ func HandleInteraction(verifier Verifier, publicKey PublicKey, logger *slog.Logg
 				w.WriteHeader(http.StatusAccepted)
 				return
 			}
+			if response.Type == discord.InteractionResponseTypeUnavailable {
+				http.Error(w, "Service Unavailable", http.StatusServiceUnavailable)
+				return
+			}
 
 			if body, err = response.ToBody(); err != nil {
 				http.Error(w, "Internal Server Error", http.StatusInternalServerError)
@@ -185,10 +189,20 @@ func HandleInteraction(verifier Verifier, publicKey PublicKey, logger *slog.Logg
 		rsBody := &bytes.Buffer{}
 		multiWriter := io.MultiWriter(w, rsBody)
 
-		if multiPart, ok := body.(*discord.MultipartBuffer); ok {
+		switch multiPart := body.(type) {
+		case *discord.MultipartBody:
+			w.Header().Set("Content-Type", multiPart.ContentType())
+			var reader io.ReadCloser
+			if reader, err = multiPart.Reader(); err == nil {
+				_, err = io.Copy(w, reader)
+			}
+			_ = multiPart.Close()
+			// the files are streamed, so only log the payload
+			rsBody.Write(multiPart.PayloadJSON)
+		case *discord.MultipartBuffer:
 			w.Header().Set("Content-Type", multiPart.ContentType)
 			_, err = io.Copy(multiWriter, multiPart.Buffer)
-		} else {
+		default:
 			w.Header().Set("Content-Type", "application/json")
 			err = json.NewEncoder(multiWriter).Encode(body)
 		}
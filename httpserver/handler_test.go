@@ -0,0 +1,35 @@
+package httpserver
+
+import (
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/disgoorg/disgo/discord"
+)
+
+type testVerifier struct{}
+
+func (testVerifier) Verify(_ PublicKey, _ []byte, _ []byte) bool { return true }
+
+func (testVerifier) SignatureSize() int { return 64 }
+
+func TestHandleInteraction_Unavailable(t *testing.T) {
+	t.Parallel()
+
+	handler := HandleInteraction(testVerifier{}, nil, slog.Default(), func(respondFunc RespondFunc, _ EventInteractionCreate) {
+		_ = respondFunc(discord.InteractionResponse{Type: discord.InteractionResponseTypeUnavailable})
+	})
+
+	rq := httptest.NewRequest(http.MethodPost, "/interactions/callback", strings.NewReader(`{"id":"1","application_id":"2","type":1,"token":"t","version":1}`))
+	rq.Header.Set("X-Signature-Ed25519", strings.Repeat("00", 64))
+	rq.Header.Set("X-Signature-Timestamp", "1")
+	rs := httptest.NewRecorder()
+	handler(rs, rq)
+
+	assert.Equal(t, http.StatusServiceUnavailable, rs.Code)
+}
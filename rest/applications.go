@@ -1,6 +1,9 @@
 package rest
 
 import (
+	"context"
+	"iter"
+
 	"github.com/disgoorg/snowflake/v2"
 
 	"github.com/disgoorg/disgo/discord"
@@ -38,6 +41,9 @@ type Applications interface {
 	UpdateApplicationRoleConnectionMetadata(applicationID snowflake.ID, newRecords []discord.ApplicationRoleConnectionMetadata, opts ...RequestOpt) ([]discord.ApplicationRoleConnectionMetadata, error)
 
 	GetEntitlements(applicationID snowflake.ID, params GetEntitlementsParams, opts ...RequestOpt) ([]discord.Entitlement, error)
+	// GetEntitlementsIter iterates over all entitlements matching the given GetEntitlementsParams.
+	// It iterates after GetEntitlementsParams.After or before GetEntitlementsParams.Before if set.
+	GetEntitlementsIter(ctx context.Context, applicationID snowflake.ID, params GetEntitlementsParams, opts ...RequestOpt) iter.Seq2[discord.Entitlement, error]
 	GetEntitlement(applicationID snowflake.ID, entitlementID snowflake.ID, opts ...RequestOpt) (*discord.Entitlement, error)
 	CreateTestEntitlement(applicationID snowflake.ID, entitlementCreate discord.TestEntitlementCreate, opts ...RequestOpt) (*discord.Entitlement, error)
 	DeleteTestEntitlement(applicationID snowflake.ID, entitlementID snowflake.ID, opts ...RequestOpt) error
@@ -225,6 +231,23 @@ func (s *applicationsImpl) GetEntitlements(applicationID snowflake.ID, params Ge
 	return
 }
 
+func (s *applicationsImpl) GetEntitlementsIter(ctx context.Context, applicationID snowflake.ID, params GetEntitlementsParams, opts ...RequestOpt) iter.Seq2[discord.Entitlement, error] {
+	startID := snowflake.ID(params.After)
+	if params.Before != 0 {
+		startID = snowflake.ID(params.Before)
+	}
+	return paginateByID(ctx, startID, params.Before == 0, params.Limit,
+		func(entitlement discord.Entitlement) snowflake.ID {
+			return entitlement.ID
+		},
+		func(ctx context.Context, before snowflake.ID, after snowflake.ID) ([]discord.Entitlement, error) {
+			params.Before = int(before)
+			params.After = int(after)
+			return s.GetEntitlements(applicationID, params, withCtx(ctx, opts)...)
+		},
+	)
+}
+
 func (s *applicationsImpl) GetEntitlement(applicationID snowflake.ID, entitlementID snowflake.ID, opts ...RequestOpt) (entitlement *discord.Entitlement, err error) {
 	err = s.client.Do(GetEntitlement.Compile(nil, applicationID, entitlementID), nil, &entitlement, opts...)
 	return
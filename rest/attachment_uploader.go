@@ -0,0 +1,134 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/disgoorg/snowflake/v2"
+
+	"github.com/disgoorg/disgo/discord"
+)
+
+var _ AttachmentUploader = (*attachmentUploaderImpl)(nil)
+
+// NewAttachmentUploader returns a new AttachmentUploader which creates the upload slots with the given Client and uploads the files with a copy of its Client.HTTPClient.
+// The copy has no timeout, so uploads of large files are only bounded by the context.Context of the upload.
+func NewAttachmentUploader(client Client) AttachmentUploader {
+	httpClient := *client.HTTPClient()
+	httpClient.Timeout = 0
+	return &attachmentUploaderImpl{client: client, channels: NewChannels(client), httpClient: &httpClient}
+}
+
+// AttachmentUploader uploads files directly to pre-signed attachment upload URLs instead of sending them in the body of API requests.
+type AttachmentUploader interface {
+	// Upload creates upload slots for the files in the given channel and uploads the files to them.
+	// It returns files referencing the uploaded attachments, which can be sent in a message to the same channel.
+	// Files with a Reader not implementing io.Seeker are read into memory to determine their size.
+	Upload(channelID snowflake.ID, files []*discord.File, opts ...RequestOpt) ([]*discord.File, error)
+
+	// UploadFile uploads size bytes of the reader to the discord.AttachmentUpload via a PUT request.
+	// The upload has no timeout, use the context.Context to cancel it.
+	UploadFile(ctx context.Context, upload discord.AttachmentUpload, reader io.Reader, size int64) error
+}
+
+type attachmentUploaderImpl struct {
+	client     Client
+	channels   Channels
+	httpClient *http.Client
+}
+
+func (u *attachmentUploaderImpl) Upload(channelID snowflake.ID, files []*discord.File, opts ...RequestOpt) ([]*discord.File, error) {
+	// the RequestOpt(s) are applied to a placeholder request to resolve the context.Context of the uploads,
+	// options like WithReason only affect the request creating the upload slots
+	cfg := defaultRequestConfig(&http.Request{URL: &url.URL{}, Header: http.Header{}})
+	cfg.apply(opts)
+
+	readers := make([]io.Reader, len(files))
+	creates := make([]discord.AttachmentUploadCreate, len(files))
+	for i, file := range files {
+		reader, size, err := sizedReader(file.Reader)
+		if err != nil {
+			return nil, fmt.Errorf("failed to determine size of file %q: %w", file.Name, err)
+		}
+		readers[i] = reader
+		creates[i] = discord.AttachmentUploadCreate{
+			ID:       i,
+			Filename: file.Name,
+			FileSize: size,
+		}
+	}
+
+	uploads, err := u.channels.CreateAttachmentUploads(channelID, creates, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	uploaded := make([]*discord.File, len(files))
+	for _, upload := range uploads {
+		if upload.ID < 0 || upload.ID >= len(files) {
+			return nil, fmt.Errorf("received attachment upload for unknown file %d", upload.ID)
+		}
+		file := files[upload.ID]
+		if err = u.UploadFile(cfg.Ctx, upload, readers[upload.ID], creates[upload.ID].FileSize); err != nil {
+			return nil, fmt.Errorf("failed to upload file %q: %w", file.Name, err)
+		}
+		uploaded[upload.ID] = discord.NewUploadedFile(file.Name, file.Description, upload.UploadFilename, file.Flags)
+	}
+	for i, file := range uploaded {
+		if file == nil {
+			return nil, fmt.Errorf("received no attachment upload for file %q", files[i].Name)
+		}
+	}
+	return uploaded, nil
+}
+
+func (u *attachmentUploaderImpl) UploadFile(ctx context.Context, upload discord.AttachmentUpload, reader io.Reader, size int64) error {
+	rq, err := http.NewRequestWithContext(ctx, http.MethodPut, upload.UploadURL, io.LimitReader(reader, size))
+	if err != nil {
+		return err
+	}
+	rq.ContentLength = size
+	rq.Header.Set("Content-Type", "application/octet-stream")
+
+	rs, err := u.httpClient.Do(rq)
+	if err != nil {
+		return fmt.Errorf("error doing upload request: %w", err)
+	}
+	defer func() {
+		_ = rs.Body.Close()
+	}()
+
+	if rs.StatusCode < 200 || rs.StatusCode >= 300 {
+		rsBody, _ := io.ReadAll(rs.Body)
+		return NewError(rq, nil, rs, rsBody)
+	}
+	return nil
+}
+
+// sizedReader returns the remaining size of the reader. Readers not implementing io.Seeker are read into memory.
+func sizedReader(reader io.Reader) (io.Reader, int64, error) {
+	if seeker, ok := reader.(io.ReadSeeker); ok {
+		offset, err := seeker.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return nil, 0, err
+		}
+		end, err := seeker.Seek(0, io.SeekEnd)
+		if err != nil {
+			return nil, 0, err
+		}
+		if _, err = seeker.Seek(offset, io.SeekStart); err != nil {
+			return nil, 0, err
+		}
+		return seeker, end - offset, nil
+	}
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, 0, err
+	}
+	return bytes.NewReader(data), int64(len(data)), nil
+}
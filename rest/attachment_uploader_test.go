@@ -0,0 +1,101 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/disgoorg/json/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/disgoorg/disgo/discord"
+)
+
+func TestAttachmentUploader_Upload(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu       sync.Mutex
+		uploaded = map[string]string{}
+	)
+	mux := http.NewServeMux()
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	mux.HandleFunc("POST /channels/1/attachments", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "cleanup", r.Header.Get("X-Audit-Log-Reason"))
+		assert.Equal(t, "1", r.URL.Query().Get("test"))
+
+		var rq discord.AttachmentUploadsCreate
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&rq))
+
+		var rs attachmentUploadsResponse
+		for _, file := range rq.Files {
+			rs.Attachments = append(rs.Attachments, discord.AttachmentUpload{
+				ID:             file.ID,
+				UploadURL:      srv.URL + "/upload/" + file.Filename,
+				UploadFilename: "uploads/" + file.Filename,
+			})
+		}
+		_ = json.NewEncoder(w).Encode(rs)
+	})
+	mux.HandleFunc("PUT /upload/{name}", func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		assert.Equal(t, int64(len(data)), r.ContentLength)
+		mu.Lock()
+		uploaded[r.PathValue("name")] = string(data)
+		mu.Unlock()
+	})
+
+	client := NewClient("", WithURL(srv.URL), WithRateLimiter(NewNoopRateLimiter()))
+	files, err := NewAttachmentUploader(client).Upload(1, []*discord.File{
+		discord.NewFile("a.txt", "first", strings.NewReader("aaa")),
+		discord.NewFile("b.txt", "", io.MultiReader(strings.NewReader("bbbb")), discord.FileFlagSpoiler),
+	}, WithReason("cleanup"), WithQueryParam("test", 1))
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{"a.txt": "aaa", "b.txt": "bbbb"}, uploaded)
+	assert.Equal(t, []*discord.File{
+		discord.NewUploadedFile("a.txt", "first", "uploads/a.txt"),
+		discord.NewUploadedFile("b.txt", "", "uploads/b.txt", discord.FileFlagSpoiler),
+	}, files)
+
+	body, err := discord.MessageCreate{Files: files}.ToBody()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"attachments":[
+		{"id":0,"description":"first","filename":"a.txt","uploaded_filename":"uploads/a.txt"},
+		{"id":1,"description":"","filename":"SPOILER_b.txt","uploaded_filename":"uploads/b.txt"}
+	]}`, string(body.(*discord.MultipartBody).PayloadJSON))
+}
+
+func TestAttachmentUploader_UploadFileTimeout(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.ReadAll(r.Body)
+		select {
+		case <-time.After(100 * time.Millisecond):
+		case <-r.Context().Done():
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client := NewClient("", WithURL(srv.URL), WithHTTPClient(&http.Client{Timeout: 50 * time.Millisecond}), WithRateLimiter(NewNoopRateLimiter()))
+	uploader := NewAttachmentUploader(client)
+	upload := discord.AttachmentUpload{UploadURL: srv.URL + "/upload"}
+
+	err := uploader.UploadFile(context.Background(), upload, strings.NewReader("aaa"), 3)
+	require.NoError(t, err, "upload must not be bound by the timeout of the http client")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err = uploader.UploadFile(ctx, upload, strings.NewReader("aaa"), 3)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+}
@@ -1,6 +1,10 @@
 package rest
 
 import (
+	"context"
+	"iter"
+	"time"
+
 	"github.com/disgoorg/snowflake/v2"
 
 	"github.com/disgoorg/disgo/discord"
@@ -28,13 +32,20 @@ type Channels interface {
 	GetMessage(channelID snowflake.ID, messageID snowflake.ID, opts ...RequestOpt) (*discord.Message, error)
 	GetMessages(channelID snowflake.ID, around snowflake.ID, before snowflake.ID, after snowflake.ID, limit int, opts ...RequestOpt) ([]discord.Message, error)
 	GetMessagesPage(channelID snowflake.ID, startID snowflake.ID, limit int, opts ...RequestOpt) Page[discord.Message]
+	// GetMessagesIter iterates over all messages before the given before ID or after the given after ID if set. If both are 0 it starts at the latest message.
+	GetMessagesIter(ctx context.Context, channelID snowflake.ID, before snowflake.ID, after snowflake.ID, limit int, opts ...RequestOpt) iter.Seq2[discord.Message, error]
 	CreateMessage(channelID snowflake.ID, messageCreate discord.MessageCreate, opts ...RequestOpt) (*discord.Message, error)
 	UpdateMessage(channelID snowflake.ID, messageID snowflake.ID, messageUpdate discord.MessageUpdate, opts ...RequestOpt) (*discord.Message, error)
 	DeleteMessage(channelID snowflake.ID, messageID snowflake.ID, opts ...RequestOpt) error
 	BulkDeleteMessages(channelID snowflake.ID, messageIDs []snowflake.ID, opts ...RequestOpt) error
 	CrosspostMessage(channelID snowflake.ID, messageID snowflake.ID, opts ...RequestOpt) (*discord.Message, error)
 
+	// CreateAttachmentUploads creates upload slots for files which can then be uploaded directly to the returned discord.AttachmentUpload.UploadURL.
+	// See AttachmentUploader for a helper which also uploads the files.
+	CreateAttachmentUploads(channelID snowflake.ID, uploads []discord.AttachmentUploadCreate, opts ...RequestOpt) ([]discord.AttachmentUpload, error)
+
 	GetReactions(channelID snowflake.ID, messageID snowflake.ID, emoji string, reactionType discord.MessageReactionType, after int, limit int, opts ...RequestOpt) ([]discord.User, error)
+	GetReactionsIter(ctx context.Context, channelID snowflake.ID, messageID snowflake.ID, emoji string, reactionType discord.MessageReactionType, after snowflake.ID, limit int, opts ...RequestOpt) iter.Seq2[discord.User, error]
 	AddReaction(channelID snowflake.ID, messageID snowflake.ID, emoji string, opts ...RequestOpt) error
 	RemoveOwnReaction(channelID snowflake.ID, messageID snowflake.ID, emoji string, opts ...RequestOpt) error
 	RemoveUserReaction(channelID snowflake.ID, messageID snowflake.ID, emoji string, userID snowflake.ID, opts ...RequestOpt) error
@@ -45,6 +56,8 @@ type Channels interface {
 	GetPinnedMessages(channelID snowflake.ID, opts ...RequestOpt) ([]discord.Message, error)
 
 	GetChannelPins(channelID snowflake.ID, before snowflake.ID, limit int, opts ...RequestOpt) (*discord.ChannelPins, error)
+	// GetChannelPinsIter iterates over all pinned messages pinned before the given time. If before is zero it starts at the latest pin.
+	GetChannelPinsIter(ctx context.Context, channelID snowflake.ID, before time.Time, limit int, opts ...RequestOpt) iter.Seq2[discord.MessagePin, error]
 	PinMessage(channelID snowflake.ID, messageID snowflake.ID, opts ...RequestOpt) error
 	UnpinMessage(channelID snowflake.ID, messageID snowflake.ID, opts ...RequestOpt) error
 
@@ -52,6 +65,7 @@ type Channels interface {
 
 	GetPollAnswerVotes(channelID snowflake.ID, messageID snowflake.ID, answerID int, after snowflake.ID, limit int, opts ...RequestOpt) ([]discord.User, error)
 	GetPollAnswerVotesPage(channelID snowflake.ID, messageID snowflake.ID, answerID int, startID snowflake.ID, limit int, opts ...RequestOpt) PollAnswerVotesPage
+	GetPollAnswerVotesIter(ctx context.Context, channelID snowflake.ID, messageID snowflake.ID, answerID int, after snowflake.ID, limit int, opts ...RequestOpt) iter.Seq2[discord.User, error]
 	ExpirePoll(channelID snowflake.ID, messageID snowflake.ID, opts ...RequestOpt) (*discord.Message, error)
 }
 
@@ -145,6 +159,21 @@ func (s *channelImpl) GetMessagesPage(channelID snowflake.ID, startID snowflake.
 	}
 }
 
+func (s *channelImpl) GetMessagesIter(ctx context.Context, channelID snowflake.ID, before snowflake.ID, after snowflake.ID, limit int, opts ...RequestOpt) iter.Seq2[discord.Message, error] {
+	startID := before
+	if after != 0 {
+		startID = after
+	}
+	return paginateByID(ctx, startID, after != 0, limit,
+		func(msg discord.Message) snowflake.ID {
+			return msg.ID
+		},
+		func(ctx context.Context, before snowflake.ID, after snowflake.ID) ([]discord.Message, error) {
+			return s.GetMessages(channelID, 0, before, after, limit, withCtx(ctx, opts)...)
+		},
+	)
+}
+
 func (s *channelImpl) CreateMessage(channelID snowflake.ID, messageCreate discord.MessageCreate, opts ...RequestOpt) (message *discord.Message, err error) {
 	body, err := messageCreate.ToBody()
 	if err != nil {
@@ -154,6 +183,12 @@ func (s *channelImpl) CreateMessage(channelID snowflake.ID, messageCreate discor
 	return
 }
 
+func (s *channelImpl) CreateAttachmentUploads(channelID snowflake.ID, uploads []discord.AttachmentUploadCreate, opts ...RequestOpt) ([]discord.AttachmentUpload, error) {
+	var rs attachmentUploadsResponse
+	err := s.client.Do(CreateAttachmentUploads.Compile(nil, channelID), discord.AttachmentUploadsCreate{Files: uploads}, &rs, opts...)
+	return rs.Attachments, err
+}
+
 func (s *channelImpl) UpdateMessage(channelID snowflake.ID, messageID snowflake.ID, messageUpdate discord.MessageUpdate, opts ...RequestOpt) (message *discord.Message, err error) {
 	body, err := messageUpdate.ToBody()
 	if err != nil {
@@ -190,6 +225,17 @@ func (s *channelImpl) GetReactions(channelID snowflake.ID, messageID snowflake.I
 	return
 }
 
+func (s *channelImpl) GetReactionsIter(ctx context.Context, channelID snowflake.ID, messageID snowflake.ID, emoji string, reactionType discord.MessageReactionType, after snowflake.ID, limit int, opts ...RequestOpt) iter.Seq2[discord.User, error] {
+	return paginateByID(ctx, after, true, limit,
+		func(user discord.User) snowflake.ID {
+			return user.ID
+		},
+		func(ctx context.Context, _ snowflake.ID, after snowflake.ID) ([]discord.User, error) {
+			return s.GetReactions(channelID, messageID, emoji, reactionType, int(after), limit, withCtx(ctx, opts)...)
+		},
+	)
+}
+
 func (s *channelImpl) AddReaction(channelID snowflake.ID, messageID snowflake.ID, emoji string, opts ...RequestOpt) error {
 	return s.client.Do(AddReaction.Compile(nil, channelID, messageID, emoji), nil, nil, opts...)
 }
@@ -228,6 +274,28 @@ func (s *channelImpl) GetChannelPins(channelID snowflake.ID, before snowflake.ID
 	return
 }
 
+func (s *channelImpl) GetChannelPinsIter(ctx context.Context, channelID snowflake.ID, before time.Time, limit int, opts ...RequestOpt) iter.Seq2[discord.MessagePin, error] {
+	return paginateByTime(ctx, before,
+		func(pin discord.MessagePin) time.Time {
+			return pin.PinnedAt
+		},
+		func(ctx context.Context, before time.Time) ([]discord.MessagePin, bool, error) {
+			values := discord.QueryValues{}
+			if !before.IsZero() {
+				values["before"] = before.Format(time.RFC3339)
+			}
+			if limit != 0 {
+				values["limit"] = limit
+			}
+			var pins *discord.ChannelPins
+			if err := s.client.Do(GetChannelPins.Compile(values, channelID), nil, &pins, withCtx(ctx, opts)...); err != nil {
+				return nil, false, err
+			}
+			return pins.Items, pins.HasMore, nil
+		},
+	)
+}
+
 func (s *channelImpl) PinMessage(channelID snowflake.ID, messageID snowflake.ID, opts ...RequestOpt) error {
 	return s.client.Do(PinMessage.Compile(nil, channelID, messageID), nil, nil, opts...)
 }
@@ -266,6 +334,17 @@ func (s *channelImpl) GetPollAnswerVotesPage(channelID snowflake.ID, messageID s
 	}
 }
 
+func (s *channelImpl) GetPollAnswerVotesIter(ctx context.Context, channelID snowflake.ID, messageID snowflake.ID, answerID int, after snowflake.ID, limit int, opts ...RequestOpt) iter.Seq2[discord.User, error] {
+	return paginateByID(ctx, after, true, limit,
+		func(user discord.User) snowflake.ID {
+			return user.ID
+		},
+		func(ctx context.Context, _ snowflake.ID, after snowflake.ID) ([]discord.User, error) {
+			return s.GetPollAnswerVotes(channelID, messageID, answerID, after, limit, withCtx(ctx, opts)...)
+		},
+	)
+}
+
 func (s *channelImpl) ExpirePoll(channelID snowflake.ID, messageID snowflake.ID, opts ...RequestOpt) (message *discord.Message, err error) {
 	err = s.client.Do(ExpirePoll.Compile(nil, channelID, messageID), nil, &message, opts...)
 	return
@@ -274,3 +353,7 @@ func (s *channelImpl) ExpirePoll(channelID snowflake.ID, messageID snowflake.ID,
 type pollAnswerVotesResponse struct {
 	Users []discord.User `json:"users"`
 }
+
+type attachmentUploadsResponse struct {
+	Attachments []discord.AttachmentUpload `json:"attachments"`
+}
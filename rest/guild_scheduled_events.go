@@ -1,6 +1,9 @@
 package rest
 
 import (
+	"context"
+	"iter"
+
 	"github.com/disgoorg/snowflake/v2"
 
 	"github.com/disgoorg/disgo/discord"
@@ -21,6 +24,8 @@ type GuildScheduledEvents interface {
 
 	GetGuildScheduledEventUsers(guildID snowflake.ID, guildScheduledEventID snowflake.ID, withMember bool, before snowflake.ID, after snowflake.ID, limit int, opts ...RequestOpt) ([]discord.GuildScheduledEventUser, error)
 	GetGuildScheduledEventUsersPage(guildID snowflake.ID, guildScheduledEventID snowflake.ID, withMember bool, startID snowflake.ID, limit int, opts ...RequestOpt) Page[discord.GuildScheduledEventUser]
+	// GetGuildScheduledEventUsersIter iterates over all users subscribed to the guild scheduled event after the given after ID or before the given before ID if set.
+	GetGuildScheduledEventUsersIter(ctx context.Context, guildID snowflake.ID, guildScheduledEventID snowflake.ID, withMember bool, before snowflake.ID, after snowflake.ID, limit int, opts ...RequestOpt) iter.Seq2[discord.GuildScheduledEventUser, error]
 }
 
 type guildScheduledEventImpl struct {
@@ -73,7 +78,7 @@ func (s *guildScheduledEventImpl) GetGuildScheduledEventUsers(guildID snowflake.
 	if after != 0 {
 		queryValues["after"] = after
 	}
-	err = s.client.Do(GetGuildScheduledEventUsers.Compile(nil, guildID, guildScheduledEventID), nil, &guildScheduledEventUsers, opts...)
+	err = s.client.Do(GetGuildScheduledEventUsers.Compile(queryValues, guildID, guildScheduledEventID), nil, &guildScheduledEventUsers, opts...)
 	return
 }
 
@@ -88,3 +93,18 @@ func (s *guildScheduledEventImpl) GetGuildScheduledEventUsersPage(guildID snowfl
 		ID: startID,
 	}
 }
+
+func (s *guildScheduledEventImpl) GetGuildScheduledEventUsersIter(ctx context.Context, guildID snowflake.ID, guildScheduledEventID snowflake.ID, withMember bool, before snowflake.ID, after snowflake.ID, limit int, opts ...RequestOpt) iter.Seq2[discord.GuildScheduledEventUser, error] {
+	startID := after
+	if before != 0 {
+		startID = before
+	}
+	return paginateByID(ctx, startID, before == 0, limit,
+		func(user discord.GuildScheduledEventUser) snowflake.ID {
+			return user.User.ID
+		},
+		func(ctx context.Context, before snowflake.ID, after snowflake.ID) ([]discord.GuildScheduledEventUser, error) {
+			return s.GetGuildScheduledEventUsers(guildID, guildScheduledEventID, withMember, before, after, limit, withCtx(ctx, opts)...)
+		},
+	)
+}
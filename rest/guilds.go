@@ -1,6 +1,8 @@
 package rest
 
 import (
+	"context"
+	"iter"
 	"time"
 
 	"github.com/disgoorg/snowflake/v2"
@@ -37,6 +39,8 @@ type Guilds interface {
 
 	GetBans(guildID snowflake.ID, before snowflake.ID, after snowflake.ID, limit int, opts ...RequestOpt) ([]discord.Ban, error)
 	GetBansPage(guildID snowflake.ID, startID snowflake.ID, limit int, opts ...RequestOpt) Page[discord.Ban]
+	// GetBansIter iterates over all bans after the given after ID or before the given before ID if set.
+	GetBansIter(ctx context.Context, guildID snowflake.ID, before snowflake.ID, after snowflake.ID, limit int, opts ...RequestOpt) iter.Seq2[discord.Ban, error]
 	GetBan(guildID snowflake.ID, userID snowflake.ID, opts ...RequestOpt) (*discord.Ban, error)
 	AddBan(guildID snowflake.ID, userID snowflake.ID, deleteMessageDuration time.Duration, opts ...RequestOpt) error
 	DeleteBan(guildID snowflake.ID, userID snowflake.ID, opts ...RequestOpt) error
@@ -54,6 +58,8 @@ type Guilds interface {
 
 	GetAuditLog(guildID snowflake.ID, userID snowflake.ID, actionType discord.AuditLogEvent, before snowflake.ID, after snowflake.ID, limit int, opts ...RequestOpt) (*discord.AuditLog, error)
 	GetAuditLogPage(guildID snowflake.ID, userID snowflake.ID, actionType discord.AuditLogEvent, startID snowflake.ID, limit int, opts ...RequestOpt) AuditLogPage
+	// GetAuditLogIter iterates over all audit log entries before the given before ID or after the given after ID if set. If both are 0 it starts at the latest entry.
+	GetAuditLogIter(ctx context.Context, guildID snowflake.ID, userID snowflake.ID, actionType discord.AuditLogEvent, before snowflake.ID, after snowflake.ID, limit int, opts ...RequestOpt) iter.Seq2[discord.AuditLogEntry, error]
 
 	GetGuildWelcomeScreen(guildID snowflake.ID, opts ...RequestOpt) (*discord.GuildWelcomeScreen, error)
 	UpdateGuildWelcomeScreen(guildID snowflake.ID, screenUpdate discord.GuildWelcomeScreenUpdate, opts ...RequestOpt) (*discord.GuildWelcomeScreen, error)
@@ -200,6 +206,21 @@ func (s *guildImpl) GetBansPage(guildID snowflake.ID, startID snowflake.ID, limi
 	}
 }
 
+func (s *guildImpl) GetBansIter(ctx context.Context, guildID snowflake.ID, before snowflake.ID, after snowflake.ID, limit int, opts ...RequestOpt) iter.Seq2[discord.Ban, error] {
+	startID := after
+	if before != 0 {
+		startID = before
+	}
+	return paginateByID(ctx, startID, before == 0, limit,
+		func(ban discord.Ban) snowflake.ID {
+			return ban.User.ID
+		},
+		func(ctx context.Context, before snowflake.ID, after snowflake.ID) ([]discord.Ban, error) {
+			return s.GetBans(guildID, before, after, limit, withCtx(ctx, opts)...)
+		},
+	)
+}
+
 func (s *guildImpl) GetBan(guildID snowflake.ID, userID snowflake.ID, opts ...RequestOpt) (ban *discord.Ban, err error) {
 	err = s.client.Do(GetBan.Compile(nil, guildID, userID), nil, &ban, opts...)
 	return
@@ -279,6 +300,25 @@ func (s *guildImpl) GetAuditLog(guildID snowflake.ID, userID snowflake.ID, actio
 	return
 }
 
+func (s *guildImpl) GetAuditLogIter(ctx context.Context, guildID snowflake.ID, userID snowflake.ID, actionType discord.AuditLogEvent, before snowflake.ID, after snowflake.ID, limit int, opts ...RequestOpt) iter.Seq2[discord.AuditLogEntry, error] {
+	startID := before
+	if after != 0 {
+		startID = after
+	}
+	return paginateByID(ctx, startID, after != 0, limit,
+		func(entry discord.AuditLogEntry) snowflake.ID {
+			return entry.ID
+		},
+		func(ctx context.Context, before snowflake.ID, after snowflake.ID) ([]discord.AuditLogEntry, error) {
+			log, err := s.GetAuditLog(guildID, userID, actionType, before, after, limit, withCtx(ctx, opts)...)
+			if err != nil {
+				return nil, err
+			}
+			return log.AuditLogEntries, nil
+		},
+	)
+}
+
 func (s *guildImpl) GetAuditLogPage(guildID snowflake.ID, userID snowflake.ID, actionType discord.AuditLogEvent, startID snowflake.ID, limit int, opts ...RequestOpt) AuditLogPage {
 	return AuditLogPage{
 		getItems: func(before snowflake.ID, after snowflake.ID) (discord.AuditLog, error) {
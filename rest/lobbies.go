@@ -0,0 +1,105 @@
+package rest
+
+import (
+	"github.com/disgoorg/snowflake/v2"
+
+	"github.com/disgoorg/disgo/discord"
+)
+
+var _ Lobbies = (*lobbyImpl)(nil)
+
+func NewLobbies(client Client) Lobbies {
+	return &lobbyImpl{client: client}
+}
+
+type Lobbies interface {
+	CreateLobby(lobbyCreate discord.LobbyCreate, opts ...RequestOpt) (*discord.Lobby, error)
+	GetLobby(lobbyID snowflake.ID, opts ...RequestOpt) (*discord.Lobby, error)
+	UpdateLobby(lobbyID snowflake.ID, lobbyUpdate discord.LobbyUpdate, opts ...RequestOpt) (*discord.Lobby, error)
+	DeleteLobby(lobbyID snowflake.ID, opts ...RequestOpt) error
+
+	// AddLobbyMember adds the user to the discord.Lobby or updates the discord.LobbyMember if the user is already a member.
+	AddLobbyMember(lobbyID snowflake.ID, userID snowflake.ID, memberAdd discord.LobbyMemberAdd, opts ...RequestOpt) (*discord.LobbyMember, error)
+	RemoveLobbyMember(lobbyID snowflake.ID, userID snowflake.ID, opts ...RequestOpt) error
+
+	// JoinOrCreateLobby joins the discord.Lobby with the given secret on behalf of the user the bearer token belongs to.
+	// If no discord.Lobby with the secret exists, it is created.
+	JoinOrCreateLobby(bearerToken string, lobbyJoin discord.LobbyJoin, opts ...RequestOpt) (*discord.Lobby, error)
+	// LeaveLobby removes the user the bearer token belongs to from the discord.Lobby.
+	LeaveLobby(bearerToken string, lobbyID snowflake.ID, opts ...RequestOpt) error
+	// LinkChannelToLobby links the channel to the discord.Lobby on behalf of the user the bearer token belongs to.
+	// The user needs the discord.LobbyMemberFlagCanLinkLobby flag.
+	LinkChannelToLobby(bearerToken string, lobbyID snowflake.ID, channelID snowflake.ID, opts ...RequestOpt) (*discord.Lobby, error)
+	// UnlinkChannelFromLobby unlinks the linked channel from the discord.Lobby on behalf of the user the bearer token belongs to.
+	UnlinkChannelFromLobby(bearerToken string, lobbyID snowflake.ID, opts ...RequestOpt) (*discord.Lobby, error)
+
+	CreateLobbyMessage(lobbyID snowflake.ID, messageCreate discord.LobbyMessageCreate, opts ...RequestOpt) (*discord.LobbyMessage, error)
+}
+
+type lobbyImpl struct {
+	client Client
+}
+
+func (s *lobbyImpl) CreateLobby(lobbyCreate discord.LobbyCreate, opts ...RequestOpt) (lobby *discord.Lobby, err error) {
+	err = s.client.Do(CreateLobby.Compile(nil), lobbyCreate, &lobby, opts...)
+	return
+}
+
+func (s *lobbyImpl) GetLobby(lobbyID snowflake.ID, opts ...RequestOpt) (lobby *discord.Lobby, err error) {
+	err = s.client.Do(GetLobby.Compile(nil, lobbyID), nil, &lobby, opts...)
+	return
+}
+
+func (s *lobbyImpl) UpdateLobby(lobbyID snowflake.ID, lobbyUpdate discord.LobbyUpdate, opts ...RequestOpt) (lobby *discord.Lobby, err error) {
+	err = s.client.Do(UpdateLobby.Compile(nil, lobbyID), lobbyUpdate, &lobby, opts...)
+	return
+}
+
+func (s *lobbyImpl) DeleteLobby(lobbyID snowflake.ID, opts ...RequestOpt) error {
+	return s.client.Do(DeleteLobby.Compile(nil, lobbyID), nil, nil, opts...)
+}
+
+func (s *lobbyImpl) AddLobbyMember(lobbyID snowflake.ID, userID snowflake.ID, memberAdd discord.LobbyMemberAdd, opts ...RequestOpt) (member *discord.LobbyMember, err error) {
+	err = s.client.Do(AddLobbyMember.Compile(nil, lobbyID, userID), memberAdd, &member, opts...)
+	return
+}
+
+func (s *lobbyImpl) RemoveLobbyMember(lobbyID snowflake.ID, userID snowflake.ID, opts ...RequestOpt) error {
+	return s.client.Do(RemoveLobbyMember.Compile(nil, lobbyID, userID), nil, nil, opts...)
+}
+
+func (s *lobbyImpl) JoinOrCreateLobby(bearerToken string, lobbyJoin discord.LobbyJoin, opts ...RequestOpt) (lobby *discord.Lobby, err error) {
+	if bearerToken == "" {
+		return nil, ErrMissingBearerToken
+	}
+	err = s.client.Do(JoinOrCreateLobby.Compile(nil), lobbyJoin, &lobby, withBearerToken(bearerToken, opts)...)
+	return
+}
+
+func (s *lobbyImpl) LeaveLobby(bearerToken string, lobbyID snowflake.ID, opts ...RequestOpt) error {
+	if bearerToken == "" {
+		return ErrMissingBearerToken
+	}
+	return s.client.Do(LeaveLobby.Compile(nil, lobbyID), nil, nil, withBearerToken(bearerToken, opts)...)
+}
+
+func (s *lobbyImpl) LinkChannelToLobby(bearerToken string, lobbyID snowflake.ID, channelID snowflake.ID, opts ...RequestOpt) (*discord.Lobby, error) {
+	return s.updateChannelLink(bearerToken, lobbyID, discord.LobbyChannelLink{ChannelID: &channelID}, opts)
+}
+
+func (s *lobbyImpl) UnlinkChannelFromLobby(bearerToken string, lobbyID snowflake.ID, opts ...RequestOpt) (*discord.Lobby, error) {
+	return s.updateChannelLink(bearerToken, lobbyID, discord.LobbyChannelLink{}, opts)
+}
+
+func (s *lobbyImpl) updateChannelLink(bearerToken string, lobbyID snowflake.ID, channelLink discord.LobbyChannelLink, opts []RequestOpt) (lobby *discord.Lobby, err error) {
+	if bearerToken == "" {
+		return nil, ErrMissingBearerToken
+	}
+	err = s.client.Do(LinkLobbyChannel.Compile(nil, lobbyID), channelLink, &lobby, withBearerToken(bearerToken, opts)...)
+	return
+}
+
+func (s *lobbyImpl) CreateLobbyMessage(lobbyID snowflake.ID, messageCreate discord.LobbyMessageCreate, opts ...RequestOpt) (message *discord.LobbyMessage, err error) {
+	err = s.client.Do(CreateLobbyMessage.Compile(nil, lobbyID), messageCreate, &message, opts...)
+	return
+}
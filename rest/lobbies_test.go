@@ -0,0 +1,40 @@
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/disgoorg/disgo/discord"
+)
+
+func TestLobbies_BearerToken(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		assert.Equal(t, "Bearer user", r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"1","application_id":"2"}`))
+	}))
+	defer srv.Close()
+
+	lobbies := NewLobbies(NewClient("bot", WithURL(srv.URL), WithRateLimiter(NewNoopRateLimiter())))
+
+	_, err := lobbies.JoinOrCreateLobby("", discord.LobbyJoin{Secret: "secret"})
+	assert.ErrorIs(t, err, ErrMissingBearerToken)
+	_, err = lobbies.LinkChannelToLobby("", 1, 3)
+	assert.ErrorIs(t, err, ErrMissingBearerToken)
+	assert.Zero(t, calls.Load())
+
+	_, err = lobbies.JoinOrCreateLobby("user", discord.LobbyJoin{Secret: "secret"})
+	require.NoError(t, err)
+	_, err = lobbies.LinkChannelToLobby("user", 1, 3)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), calls.Load())
+}
@@ -1,6 +1,9 @@
 package rest
 
 import (
+	"context"
+	"iter"
+
 	"github.com/disgoorg/snowflake/v2"
 
 	"github.com/disgoorg/disgo/discord"
@@ -15,6 +18,8 @@ func NewMembers(client Client) Members {
 type Members interface {
 	GetMember(guildID snowflake.ID, userID snowflake.ID, opts ...RequestOpt) (*discord.Member, error)
 	GetMembers(guildID snowflake.ID, limit int, after snowflake.ID, opts ...RequestOpt) ([]discord.Member, error)
+	// GetMembersIter iterates over all members after the given after ID in pages of limit members, 1000 if limit is 0. This requires the gateway.IntentGuildMembers intent.
+	GetMembersIter(ctx context.Context, guildID snowflake.ID, after snowflake.ID, limit int, opts ...RequestOpt) iter.Seq2[discord.Member, error]
 	SearchMembers(guildID snowflake.ID, query string, limit int, opts ...RequestOpt) ([]discord.Member, error)
 	AddMember(guildID snowflake.ID, userID snowflake.ID, memberAdd discord.MemberAdd, opts ...RequestOpt) (*discord.Member, error)
 	RemoveMember(guildID snowflake.ID, userID snowflake.ID, opts ...RequestOpt) error
@@ -57,6 +62,21 @@ func (s *memberImpl) GetMembers(guildID snowflake.ID, limit int, after snowflake
 	return
 }
 
+func (s *memberImpl) GetMembersIter(ctx context.Context, guildID snowflake.ID, after snowflake.ID, limit int, opts ...RequestOpt) iter.Seq2[discord.Member, error] {
+	// the endpoint returns a single member if no limit is set
+	if limit <= 0 {
+		limit = 1000
+	}
+	return paginateByID(ctx, after, true, limit,
+		func(member discord.Member) snowflake.ID {
+			return member.User.ID
+		},
+		func(ctx context.Context, _ snowflake.ID, after snowflake.ID) ([]discord.Member, error) {
+			return s.GetMembers(guildID, limit, after, withCtx(ctx, opts)...)
+		},
+	)
+}
+
 func (s *memberImpl) SearchMembers(guildID snowflake.ID, query string, limit int, opts ...RequestOpt) (members []discord.Member, err error) {
 	values := discord.QueryValues{}
 	if query != "" {
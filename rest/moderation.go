@@ -0,0 +1,289 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/disgoorg/snowflake/v2"
+
+	"github.com/disgoorg/disgo/discord"
+)
+
+const (
+	// MaxBulkDeleteMessages is the maximum number of messages which can be deleted with a single BulkDeleteMessages request
+	MaxBulkDeleteMessages = 100
+	// MaxBulkBanUsers is the maximum number of users which can be banned with a single BulkBan request
+	MaxBulkBanUsers = 200
+	// BulkDeleteMaxAge is the maximum age of messages which can be deleted with BulkDeleteMessages
+	BulkDeleteMaxAge = 14 * 24 * time.Hour
+)
+
+// ErrBanFailed is the error of users discord reported as failed in a BulkBan
+var ErrBanFailed = errors.New("failed to ban user")
+
+var _ Moderation = (*moderationImpl)(nil)
+
+// NewModeration returns a new Moderation using the given Client
+func NewModeration(client Client) Moderation {
+	return &moderationImpl{
+		channels: NewChannels(client),
+		guilds:   NewGuilds(client),
+		members:  NewMembers(client),
+	}
+}
+
+// Moderation provides bulk moderation operations built on top of Channels, Guilds & Members.
+// All operations can be cancelled via their context.Context, report their progress via the optional ModerationProgressFunc
+// and send their requests with PriorityLow unless overridden with WithPriority, so they don't block time-critical requests.
+// When cancelled, the ModerationResult contains all targets processed so far.
+type Moderation interface {
+	// PurgeMessages deletes all messages in the channel matching the MessageFilter, starting at the newest message.
+	// Messages younger than 14 days are deleted with BulkDeleteMessages, older ones one by one.
+	PurgeMessages(ctx context.Context, channelID snowflake.ID, filter MessageFilter, progress ModerationProgressFunc, opts ...RequestOpt) (ModerationResult, error)
+
+	// MassBan bans all users in batches of MaxBulkBanUsers via BulkBan.
+	MassBan(ctx context.Context, guildID snowflake.ID, userIDs []snowflake.ID, deleteMessageDuration time.Duration, progress ModerationProgressFunc, opts ...RequestOpt) (ModerationResult, error)
+
+	// MassKick kicks all members one by one.
+	MassKick(ctx context.Context, guildID snowflake.ID, userIDs []snowflake.ID, progress ModerationProgressFunc, opts ...RequestOpt) (ModerationResult, error)
+
+	// MassAddRole adds the role to all members one by one.
+	MassAddRole(ctx context.Context, guildID snowflake.ID, userIDs []snowflake.ID, roleID snowflake.ID, progress ModerationProgressFunc, opts ...RequestOpt) (ModerationResult, error)
+
+	// MassRemoveRole removes the role from all members one by one.
+	MassRemoveRole(ctx context.Context, guildID snowflake.ID, userIDs []snowflake.ID, roleID snowflake.ID, progress ModerationProgressFunc, opts ...RequestOpt) (ModerationResult, error)
+}
+
+// MessageFilter selects the messages deleted by Moderation.PurgeMessages. All set conditions must match.
+type MessageFilter struct {
+	// AuthorIDs only matches messages sent by one of the authors
+	AuthorIDs []snowflake.ID
+	// Content only matches messages with content matching the regular expression
+	Content *regexp.Regexp
+	// After only matches messages sent after this time. Scanning stops at the first older message
+	After time.Time
+	// Before only matches messages sent before this time
+	Before time.Time
+	// HasAttachments only matches messages with (true) or without (false) attachments
+	HasAttachments *bool
+	// Func is a custom filter function
+	Func func(message discord.Message) bool
+	// Limit is the maximum number of messages to delete. 0 means no limit
+	Limit int
+	// ScanLimit is the maximum number of messages to scan. 0 means no limit
+	ScanLimit int
+}
+
+// Matches returns true if the message matches all set conditions of the MessageFilter
+func (f MessageFilter) Matches(message discord.Message) bool {
+	if len(f.AuthorIDs) > 0 && !slices.Contains(f.AuthorIDs, message.Author.ID) {
+		return false
+	}
+	if f.Content != nil && !f.Content.MatchString(message.Content) {
+		return false
+	}
+	createdAt := message.ID.Time()
+	if !f.After.IsZero() && !createdAt.After(f.After) {
+		return false
+	}
+	if !f.Before.IsZero() && !createdAt.Before(f.Before) {
+		return false
+	}
+	if f.HasAttachments != nil && *f.HasAttachments != (len(message.Attachments) > 0) {
+		return false
+	}
+	if f.Func != nil && !f.Func(message) {
+		return false
+	}
+	return true
+}
+
+// ModerationProgress is reported after each processed batch of a Moderation operation.
+type ModerationProgress struct {
+	// Total is the number of targets or -1 if it is unknown like for Moderation.PurgeMessages
+	Total int
+	// Scanned is the number of scanned messages for Moderation.PurgeMessages
+	Scanned int
+	// Succeeded is the number of targets which have been processed successfully
+	Succeeded int
+	// Failed is the number of targets which failed
+	Failed int
+}
+
+// Processed returns the number of processed targets
+func (p ModerationProgress) Processed() int {
+	return p.Succeeded + p.Failed
+}
+
+// ModerationProgressFunc is called with the current ModerationProgress of a Moderation operation
+type ModerationProgressFunc func(progress ModerationProgress)
+
+// ModerationResult holds the targets of a Moderation operation by their outcome
+type ModerationResult struct {
+	// Succeeded are the ids of all successfully processed targets
+	Succeeded []snowflake.ID
+	// Failed are the ids of all failed targets with their error
+	Failed map[snowflake.ID]error
+}
+
+type moderationImpl struct {
+	channels Channels
+	guilds   Guilds
+	members  Members
+}
+
+// moderationOperation tracks the ModerationResult & ModerationProgress of a Moderation operation
+type moderationOperation struct {
+	result   ModerationResult
+	progress ModerationProgress
+	report   ModerationProgressFunc
+}
+
+func newModerationOperation(total int, report ModerationProgressFunc) *moderationOperation {
+	return &moderationOperation{
+		result:   ModerationResult{Failed: map[snowflake.ID]error{}},
+		progress: ModerationProgress{Total: total},
+		report:   report,
+	}
+}
+
+func (o *moderationOperation) done(ids []snowflake.ID, err error) {
+	if err != nil {
+		for _, id := range ids {
+			o.result.Failed[id] = err
+		}
+		o.progress.Failed += len(ids)
+		return
+	}
+	o.result.Succeeded = append(o.result.Succeeded, ids...)
+	o.progress.Succeeded += len(ids)
+}
+
+func (o *moderationOperation) notify() {
+	if o.report != nil {
+		o.report(o.progress)
+	}
+}
+
+// moderationOpts returns the RequestOpt(s) for a Moderation operation which use PriorityLow unless overridden
+func moderationOpts(ctx context.Context, opts []RequestOpt) []RequestOpt {
+	return withCtx(ctx, append([]RequestOpt{WithPriority(PriorityLow)}, opts...))
+}
+
+func (s *moderationImpl) PurgeMessages(ctx context.Context, channelID snowflake.ID, filter MessageFilter, progress ModerationProgressFunc, opts ...RequestOpt) (ModerationResult, error) {
+	op := newModerationOperation(-1, progress)
+	opts = moderationOpts(ctx, opts)
+
+	var batch []snowflake.ID
+	flush := func() {
+		switch len(batch) {
+		case 0:
+			return
+		case 1:
+			op.done(batch, s.channels.DeleteMessage(channelID, batch[0], opts...))
+		default:
+			op.done(batch, s.channels.BulkDeleteMessages(channelID, batch, opts...))
+		}
+		batch = nil
+		op.notify()
+	}
+
+	// start at the first message sent before filter.Before instead of scanning all newer messages
+	var before snowflake.ID
+	if !filter.Before.IsZero() {
+		before = snowflake.New(filter.Before)
+	}
+
+	var matched int
+	for message, err := range s.channels.GetMessagesIter(ctx, channelID, before, 0, MaxBulkDeleteMessages, opts...) {
+		if err != nil {
+			flush()
+			return op.result, err
+		}
+		createdAt := message.ID.Time()
+		if !filter.After.IsZero() && !createdAt.After(filter.After) {
+			break
+		}
+		op.progress.Scanned++
+		if filter.Matches(message) {
+			matched++
+			// keep a margin so messages don't exceed the max age until the request is sent
+			if time.Since(createdAt) < BulkDeleteMaxAge-time.Minute {
+				batch = append(batch, message.ID)
+				if len(batch) == MaxBulkDeleteMessages {
+					flush()
+				}
+			} else {
+				op.done([]snowflake.ID{message.ID}, s.channels.DeleteMessage(channelID, message.ID, opts...))
+				op.notify()
+			}
+		}
+		if filter.Limit > 0 && matched >= filter.Limit || filter.ScanLimit > 0 && op.progress.Scanned >= filter.ScanLimit {
+			break
+		}
+		if err = ctx.Err(); err != nil {
+			flush()
+			return op.result, err
+		}
+	}
+	flush()
+	return op.result, ctx.Err()
+}
+
+func (s *moderationImpl) MassBan(ctx context.Context, guildID snowflake.ID, userIDs []snowflake.ID, deleteMessageDuration time.Duration, progress ModerationProgressFunc, opts ...RequestOpt) (ModerationResult, error) {
+	op := newModerationOperation(len(userIDs), progress)
+	opts = moderationOpts(ctx, opts)
+
+	for batch := range slices.Chunk(userIDs, MaxBulkBanUsers) {
+		if err := ctx.Err(); err != nil {
+			return op.result, err
+		}
+		result, err := s.guilds.BulkBan(guildID, discord.BulkBan{
+			UserIDs:              batch,
+			DeleteMessageSeconds: int(deleteMessageDuration.Seconds()),
+		}, opts...)
+		if err != nil {
+			op.done(batch, err)
+		} else {
+			op.done(result.BannedUsers, nil)
+			op.done(result.FailedUsers, ErrBanFailed)
+		}
+		op.notify()
+	}
+	return op.result, nil
+}
+
+func (s *moderationImpl) MassKick(ctx context.Context, guildID snowflake.ID, userIDs []snowflake.ID, progress ModerationProgressFunc, opts ...RequestOpt) (ModerationResult, error) {
+	return s.forEachMember(ctx, userIDs, progress, func(userID snowflake.ID, opts []RequestOpt) error {
+		return s.members.RemoveMember(guildID, userID, opts...)
+	}, opts)
+}
+
+func (s *moderationImpl) MassAddRole(ctx context.Context, guildID snowflake.ID, userIDs []snowflake.ID, roleID snowflake.ID, progress ModerationProgressFunc, opts ...RequestOpt) (ModerationResult, error) {
+	return s.forEachMember(ctx, userIDs, progress, func(userID snowflake.ID, opts []RequestOpt) error {
+		return s.members.AddMemberRole(guildID, userID, roleID, opts...)
+	}, opts)
+}
+
+func (s *moderationImpl) MassRemoveRole(ctx context.Context, guildID snowflake.ID, userIDs []snowflake.ID, roleID snowflake.ID, progress ModerationProgressFunc, opts ...RequestOpt) (ModerationResult, error) {
+	return s.forEachMember(ctx, userIDs, progress, func(userID snowflake.ID, opts []RequestOpt) error {
+		return s.members.RemoveMemberRole(guildID, userID, roleID, opts...)
+	}, opts)
+}
+
+func (s *moderationImpl) forEachMember(ctx context.Context, userIDs []snowflake.ID, progress ModerationProgressFunc, fn func(userID snowflake.ID, opts []RequestOpt) error, opts []RequestOpt) (ModerationResult, error) {
+	op := newModerationOperation(len(userIDs), progress)
+	opts = moderationOpts(ctx, opts)
+
+	for _, userID := range userIDs {
+		if err := ctx.Err(); err != nil {
+			return op.result, err
+		}
+		op.done([]snowflake.ID{userID}, fn(userID, opts))
+		op.notify()
+	}
+	return op.result, nil
+}
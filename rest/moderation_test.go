@@ -0,0 +1,139 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/disgoorg/disgo/discord"
+)
+
+type moderationChannels struct {
+	Channels
+	messages    []discord.Message
+	before      snowflake.ID
+	bulkDeleted [][]snowflake.ID
+	deleted     []snowflake.ID
+}
+
+func (c *moderationChannels) GetMessagesIter(_ context.Context, _ snowflake.ID, before snowflake.ID, _ snowflake.ID, _ int, _ ...RequestOpt) iter.Seq2[discord.Message, error] {
+	c.before = before
+	return func(yield func(discord.Message, error) bool) {
+		for _, message := range c.messages {
+			if before != 0 && message.ID >= before {
+				continue
+			}
+			if !yield(message, nil) {
+				return
+			}
+		}
+	}
+}
+
+func (c *moderationChannels) BulkDeleteMessages(_ snowflake.ID, messageIDs []snowflake.ID, _ ...RequestOpt) error {
+	c.bulkDeleted = append(c.bulkDeleted, messageIDs)
+	return nil
+}
+
+func (c *moderationChannels) DeleteMessage(_ snowflake.ID, messageID snowflake.ID, _ ...RequestOpt) error {
+	c.deleted = append(c.deleted, messageID)
+	return nil
+}
+
+type moderationMembers struct {
+	Members
+	cancel context.CancelFunc
+	kicked []snowflake.ID
+}
+
+func (m *moderationMembers) RemoveMember(_ snowflake.ID, userID snowflake.ID, _ ...RequestOpt) error {
+	m.kicked = append(m.kicked, userID)
+	if len(m.kicked) == 2 {
+		m.cancel()
+	}
+	if userID == 2 {
+		return errors.New("missing permissions")
+	}
+	return nil
+}
+
+func TestModeration_PurgeMessages(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	message := func(age time.Duration, authorID snowflake.ID, content string) discord.Message {
+		return discord.Message{
+			ID:      snowflake.New(now.Add(-age)),
+			Author:  discord.User{ID: authorID},
+			Content: content,
+		}
+	}
+	channels := &moderationChannels{messages: []discord.Message{
+		message(time.Minute, 1, "spam"),
+		message(2*time.Minute, 2, "spam"),
+		message(3*time.Minute, 1, "hello"),
+		message(4*time.Minute, 1, "more spam"),
+		message(20*24*time.Hour, 1, "old spam"),
+		message(30*24*time.Hour, 1, "too old spam"),
+	}}
+	s := &moderationImpl{channels: channels}
+
+	var progress []ModerationProgress
+	result, err := s.PurgeMessages(context.Background(), 1, MessageFilter{
+		AuthorIDs: []snowflake.ID{1},
+		Content:   regexp.MustCompile("spam"),
+		After:     now.Add(-25 * 24 * time.Hour),
+	}, func(p ModerationProgress) {
+		progress = append(progress, p)
+	})
+	require.NoError(t, err)
+
+	msgs := channels.messages
+	assert.Equal(t, []snowflake.ID{msgs[4].ID}, channels.deleted)
+	assert.Equal(t, [][]snowflake.ID{{msgs[0].ID, msgs[3].ID}}, channels.bulkDeleted)
+	assert.Len(t, result.Succeeded, 3)
+	assert.Empty(t, result.Failed)
+	assert.Equal(t, ModerationProgress{Total: -1, Scanned: 5, Succeeded: 3}, progress[len(progress)-1])
+}
+
+func TestModeration_PurgeMessagesBefore(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	channels := &moderationChannels{messages: []discord.Message{
+		{ID: snowflake.New(now.Add(-time.Minute))},
+		{ID: snowflake.New(now.Add(-3 * time.Minute))},
+		{ID: snowflake.New(now.Add(-4 * time.Minute))},
+	}}
+	s := &moderationImpl{channels: channels}
+
+	before := now.Add(-2 * time.Minute)
+	result, err := s.PurgeMessages(context.Background(), 1, MessageFilter{Before: before}, nil)
+	require.NoError(t, err)
+
+	// the first page already starts before filter.Before, so newer messages are not scanned
+	assert.Equal(t, snowflake.New(before), channels.before)
+	assert.Equal(t, []snowflake.ID{channels.messages[1].ID, channels.messages[2].ID}, result.Succeeded)
+}
+
+func TestModeration_MassKickCancel(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	members := &moderationMembers{cancel: cancel}
+	s := &moderationImpl{members: members}
+
+	result, err := s.MassKick(ctx, 1, []snowflake.ID{1, 2, 3}, nil)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, []snowflake.ID{1, 2}, members.kicked)
+	assert.Equal(t, []snowflake.ID{1}, result.Succeeded)
+	assert.Contains(t, result.Failed, snowflake.ID(2))
+}
@@ -0,0 +1,120 @@
+package rest
+
+import (
+	"context"
+	"iter"
+	"time"
+
+	"github.com/disgoorg/snowflake/v2"
+)
+
+type iterPage[T any, C any] struct {
+	items []T
+	next  C
+	more  bool
+	err   error
+}
+
+// paginate returns an iter.Seq2 which yields the items of all pages returned by fetch, starting at the given cursor.
+// The next page is fetched concurrently while the items of the current page are yielded.
+// Iteration stops after the first error, which is yielded together with the zero value of T.
+func paginate[T any, C any](ctx context.Context, cursor C, fetch func(ctx context.Context, cursor C) (items []T, next C, more bool, err error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		prefetch := func(cursor C) <-chan iterPage[T, C] {
+			// buffered so the goroutine never blocks if the consumer stops early
+			ch := make(chan iterPage[T, C], 1)
+			go func() {
+				var page iterPage[T, C]
+				page.items, page.next, page.more, page.err = fetch(ctx, cursor)
+				ch <- page
+			}()
+			return ch
+		}
+
+		var zero T
+		pending := prefetch(cursor)
+		for pending != nil {
+			var page iterPage[T, C]
+			select {
+			case <-ctx.Done():
+				yield(zero, ctx.Err())
+				return
+			case page = <-pending:
+			}
+			if page.err != nil {
+				yield(zero, page.err)
+				return
+			}
+
+			pending = nil
+			if page.more {
+				pending = prefetch(page.next)
+			}
+
+			for _, item := range page.items {
+				if err := ctx.Err(); err != nil {
+					yield(zero, err)
+					return
+				}
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// paginateByID paginates endpoints which use before & after snowflake.ID cursors.
+// If forward is true the next page is requested after the highest ID of the current page, otherwise before the lowest ID.
+// A page with fewer items than limit is considered the last page.
+func paginateByID[T any](ctx context.Context, startID snowflake.ID, forward bool, limit int, getID func(t T) snowflake.ID, fetch func(ctx context.Context, before snowflake.ID, after snowflake.ID) ([]T, error)) iter.Seq2[T, error] {
+	return paginate(ctx, startID, func(ctx context.Context, cursor snowflake.ID) ([]T, snowflake.ID, bool, error) {
+		var (
+			items []T
+			err   error
+		)
+		if forward {
+			items, err = fetch(ctx, 0, cursor)
+		} else {
+			items, err = fetch(ctx, cursor, 0)
+		}
+		if err != nil || len(items) == 0 {
+			return nil, cursor, false, err
+		}
+
+		next := getID(items[0])
+		for _, item := range items[1:] {
+			id := getID(item)
+			if forward && id > next || !forward && id < next {
+				next = id
+			}
+		}
+		return items, next, limit <= 0 || len(items) >= limit, nil
+	})
+}
+
+// paginateByTime paginates endpoints which use a before timestamp cursor & a has_more flag.
+func paginateByTime[T any](ctx context.Context, before time.Time, getTime func(t T) time.Time, fetch func(ctx context.Context, before time.Time) ([]T, bool, error)) iter.Seq2[T, error] {
+	return paginate(ctx, before, func(ctx context.Context, cursor time.Time) ([]T, time.Time, bool, error) {
+		items, hasMore, err := fetch(ctx, cursor)
+		if err != nil || len(items) == 0 {
+			return nil, cursor, false, err
+		}
+
+		next := getTime(items[0])
+		for _, item := range items[1:] {
+			if t := getTime(item); t.Before(next) {
+				next = t
+			}
+		}
+		return items, next, hasMore, nil
+	})
+}
+
+// withCtx returns a copy of opts with the given context.Context applied last.
+func withCtx(ctx context.Context, opts []RequestOpt) []RequestOpt {
+	return append(append(make([]RequestOpt, 0, len(opts)+1), opts...), WithCtx(ctx))
+}
@@ -0,0 +1,198 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newIterTestClient(t *testing.T, handler func(query map[string]string) string) (Client, func() []map[string]string) {
+	t.Helper()
+
+	var (
+		mu      sync.Mutex
+		queries []map[string]string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := map[string]string{}
+		for key := range r.URL.Query() {
+			query[key] = r.URL.Query().Get(key)
+		}
+		mu.Lock()
+		queries = append(queries, query)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(handler(query)))
+	}))
+	t.Cleanup(srv.Close)
+
+	return NewClient("", WithURL(srv.URL), WithRateLimiter(NewNoopRateLimiter())), func() []map[string]string {
+		mu.Lock()
+		defer mu.Unlock()
+		return queries
+	}
+}
+
+func threadJSON(id snowflake.ID, archiveTimestamp time.Time) string {
+	return fmt.Sprintf(`{"id":"%d","type":12,"guild_id":"1","parent_id":"2","thread_metadata":{"archived":true,"archive_timestamp":"%s"}}`, id, archiveTimestamp.Format(time.RFC3339))
+}
+
+func TestPaginateByID(t *testing.T) {
+	t.Parallel()
+
+	ids := []snowflake.ID{1, 2, 3, 4, 5, 6, 7}
+	fetch := func(_ context.Context, before snowflake.ID, after snowflake.ID) ([]snowflake.ID, error) {
+		var page []snowflake.ID
+		for _, id := range ids {
+			if (after == 0 || id > after) && (before == 0 || id < before) {
+				page = append(page, id)
+			}
+		}
+		if before != 0 && len(page) > 3 {
+			page = page[len(page)-3:]
+		} else if len(page) > 3 {
+			page = page[:3]
+		}
+		return page, nil
+	}
+	getID := func(id snowflake.ID) snowflake.ID { return id }
+
+	var forward []snowflake.ID
+	for id, err := range paginateByID(context.Background(), 0, true, 3, getID, fetch) {
+		assert.NoError(t, err)
+		forward = append(forward, id)
+	}
+	assert.Equal(t, ids, forward)
+
+	var backward []snowflake.ID
+	for id, err := range paginateByID(context.Background(), 6, false, 3, getID, fetch) {
+		assert.NoError(t, err)
+		backward = append(backward, id)
+	}
+	assert.Equal(t, []snowflake.ID{3, 4, 5, 1, 2}, backward)
+}
+
+func TestPaginate_Cancel(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	fetch := func(_ context.Context, cursor int) ([]int, int, bool, error) {
+		return []int{cursor, cursor + 1}, cursor + 2, true, nil
+	}
+
+	var (
+		items   []int
+		lastErr error
+	)
+	for item, err := range paginate(ctx, 0, fetch) {
+		if err != nil {
+			lastErr = err
+			break
+		}
+		items = append(items, item)
+		if len(items) == 3 {
+			cancel()
+		}
+	}
+	assert.Equal(t, []int{0, 1, 2}, items)
+	assert.ErrorIs(t, lastErr, context.Canceled)
+}
+
+func TestGetMembersIter_DefaultLimit(t *testing.T) {
+	t.Parallel()
+
+	client, queries := newIterTestClient(t, func(query map[string]string) string {
+		if query["after"] != "0" {
+			return `[]`
+		}
+		return `[{"user":{"id":"1","username":"a"}},{"user":{"id":"2","username":"b"}}]`
+	})
+
+	var ids []snowflake.ID
+	for member, err := range NewMembers(client).GetMembersIter(context.Background(), 3, 0, 0) {
+		require.NoError(t, err)
+		assert.Equal(t, snowflake.ID(3), member.GuildID)
+		ids = append(ids, member.User.ID)
+	}
+	assert.Equal(t, []snowflake.ID{1, 2}, ids)
+	// a page with fewer members than the limit is the last page
+	assert.Equal(t, []map[string]string{{"after": "0", "limit": "1000"}}, queries())
+}
+
+func TestGetJoinedPrivateArchivedThreadsIter(t *testing.T) {
+	t.Parallel()
+
+	// joined private archived threads are sorted by ID descending, their archive timestamps are unrelated
+	threadIDs := []snowflake.ID{50, 40, 30, 20, 10}
+	client, queries := newIterTestClient(t, func(query map[string]string) string {
+		before, _ := strconv.ParseUint(query["before"], 10, 64)
+		var threads []string
+		for _, id := range threadIDs {
+			if (before == 0 || uint64(id) < before) && len(threads) < 2 {
+				threads = append(threads, threadJSON(id, time.Unix(int64(id)%3, 0)))
+			}
+		}
+		return `{"threads":[` + strings.Join(threads, ",") + `],"members":[],"has_more":false}`
+	})
+
+	var ids []snowflake.ID
+	for thread, err := range NewThreads(client).GetJoinedPrivateArchivedThreadsIter(context.Background(), 2, 0, 2) {
+		require.NoError(t, err)
+		ids = append(ids, thread.ID())
+	}
+	assert.Equal(t, threadIDs, ids)
+	assert.Equal(t, []map[string]string{
+		{"limit": "2"},
+		{"before": "40", "limit": "2"},
+		{"before": "20", "limit": "2"},
+	}, queries())
+}
+
+func TestGetPublicArchivedThreadsIter(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	timestamps := []time.Time{start.Add(4 * time.Hour), start.Add(3 * time.Hour), start.Add(2 * time.Hour), start.Add(time.Hour), start}
+	client, queries := newIterTestClient(t, func(query map[string]string) string {
+		var before time.Time
+		if v, ok := query["before"]; ok {
+			before, _ = time.Parse(time.RFC3339, v)
+		}
+		var threads []string
+		hasMore := false
+		for i, timestamp := range timestamps {
+			if !before.IsZero() && !timestamp.Before(before) {
+				continue
+			}
+			if len(threads) == 2 {
+				hasMore = true
+				break
+			}
+			threads = append(threads, threadJSON(snowflake.ID(i+1), timestamp))
+		}
+		return fmt.Sprintf(`{"threads":[%s],"members":[],"has_more":%t}`, strings.Join(threads, ","), hasMore)
+	})
+
+	var ids []snowflake.ID
+	for thread, err := range NewThreads(client).GetPublicArchivedThreadsIter(context.Background(), 2, time.Time{}, 2) {
+		require.NoError(t, err)
+		ids = append(ids, thread.ID())
+	}
+	assert.Equal(t, []snowflake.ID{1, 2, 3, 4, 5}, ids)
+	assert.Equal(t, []map[string]string{
+		{"limit": "2"},
+		{"before": timestamps[1].Format(time.RFC3339), "limit": "2"},
+		{"before": timestamps[3].Format(time.RFC3339), "limit": "2"},
+	}, queries())
+}
@@ -23,6 +23,10 @@ type requestConfig struct {
 	Ctx     context.Context
 	Checks  []Check
 	Delay   time.Duration
+
+	Idempotent  *bool
+	Middlewares Middlewares
+	Priority    *Priority
 }
 
 // Check is a function which gets executed right before a request is made
@@ -61,6 +65,29 @@ func WithDelay(delay time.Duration) RequestOpt {
 	}
 }
 
+// WithIdempotent overrides whether the request is safe to be retried on transient errors by the RetryPolicy.
+// Use this to opt in non-idempotent requests like CreateMessage or to opt out idempotent ones
+func WithIdempotent(idempotent bool) RequestOpt {
+	return func(config *requestConfig) {
+		config.Idempotent = &idempotent
+	}
+}
+
+// WithPriority sets the Priority of the request in the RateLimiter.
+// Use PriorityHigh for time-critical requests like interaction followups and PriorityLow for background jobs
+func WithPriority(priority Priority) RequestOpt {
+	return func(config *requestConfig) {
+		config.Priority = &priority
+	}
+}
+
+// WithRequestMiddlewares adds Middleware(s) which only wrap this request. They run after the Middleware(s) of the rest client
+func WithRequestMiddlewares(middlewares ...Middleware) RequestOpt {
+	return func(config *requestConfig) {
+		config.Middlewares = append(config.Middlewares, middlewares...)
+	}
+}
+
 // WithHeader adds a custom header to the request
 func WithHeader(key string, value string) RequestOpt {
 	return func(config *requestConfig) {
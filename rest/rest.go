@@ -24,6 +24,8 @@ type Rest interface {
 	Stickers
 	SKUs
 	GuildScheduledEvents
+	Lobbies
+	Moderation
 }
 
 var _ Rest = (*restImpl)(nil)
@@ -52,6 +54,8 @@ func New(client Client) Rest {
 		Stickers:             NewStickers(client),
 		SKUs:                 NewSKUs(client),
 		GuildScheduledEvents: NewGuildScheduledEvents(client),
+		Lobbies:              NewLobbies(client),
+		Moderation:           NewModeration(client),
 	}
 }
 
@@ -78,4 +82,6 @@ type restImpl struct {
 	Stickers
 	SKUs
 	GuildScheduledEvents
+	Lobbies
+	Moderation
 }
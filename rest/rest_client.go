@@ -3,11 +3,14 @@ package rest
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/disgoorg/json/v2"
@@ -59,15 +62,22 @@ func (c *clientImpl) RateLimiter() RateLimiter {
 	return c.config.RateLimiter
 }
 
-func (c *clientImpl) retry(endpoint *CompiledEndpoint, rqBody any, rsBody any, tries int, opts []RequestOpt) error {
+func (c *clientImpl) retry(endpoint *CompiledEndpoint, rqBody any, rsBody any, tries int, attempt int, opts []RequestOpt) error {
 	var (
 		rawRqBody   []byte
 		err         error
 		contentType string
+		multipart   *discord.MultipartBody
 	)
 
 	if rqBody != nil {
 		switch v := rqBody.(type) {
+		case *discord.MultipartBody:
+			// the files are streamed, so only the payload is available as raw body for logging & errors
+			multipart = v
+			contentType = v.ContentType()
+			rawRqBody = v.PayloadJSON
+
 		case *discord.MultipartBuffer:
 			contentType = v.ContentType
 			rawRqBody = v.Buffer.Bytes()
@@ -85,10 +95,22 @@ func (c *clientImpl) retry(endpoint *CompiledEndpoint, rqBody any, rsBody any, t
 		c.config.Logger.Debug("new request", slog.String("endpoint", endpoint.URL), slog.String("body", string(rawRqBody)))
 	}
 
-	rq, err := http.NewRequest(endpoint.Endpoint.Method, c.config.URL+endpoint.URL, bytes.NewReader(rawRqBody))
+	var body io.Reader = bytes.NewReader(rawRqBody)
+	if multipart != nil {
+		// rewinds all files for retries
+		if body, err = multipart.Reader(); err != nil {
+			return fmt.Errorf("failed to read multipart body: %w", err)
+		}
+	}
+
+	rq, err := http.NewRequest(endpoint.Endpoint.Method, c.config.URL+endpoint.URL, body)
 	if err != nil {
 		return err
 	}
+	if multipart != nil {
+		rq.ContentLength = multipart.ContentLength()
+		rq.GetBody = multipart.Reader
+	}
 
 	rq.Header.Set("User-Agent", c.config.UserAgent)
 	if contentType != "" {
@@ -103,6 +125,11 @@ func (c *clientImpl) retry(endpoint *CompiledEndpoint, rqBody any, rsBody any, t
 	cfg := defaultRequestConfig(rq)
 	cfg.apply(opts)
 
+	idempotent := c.config.RetryPolicy.Idempotent(endpoint.Endpoint)
+	if cfg.Idempotent != nil {
+		idempotent = *cfg.Idempotent
+	}
+
 	if cfg.Delay > 0 {
 		timer := time.NewTimer(cfg.Delay)
 		defer timer.Stop()
@@ -114,7 +141,11 @@ func (c *clientImpl) retry(endpoint *CompiledEndpoint, rqBody any, rsBody any, t
 	}
 
 	// wait for rate limits
-	err = c.RateLimiter().WaitBucket(cfg.Ctx, endpoint)
+	rateLimitCtx := cfg.Ctx
+	if cfg.Priority != nil {
+		rateLimitCtx = ContextWithPriority(rateLimitCtx, *cfg.Priority)
+	}
+	err = c.RateLimiter().WaitBucket(rateLimitCtx, endpoint)
 	if err != nil {
 		return fmt.Errorf("error locking bucket in rest client: %w", err)
 	}
@@ -127,29 +158,47 @@ func (c *clientImpl) retry(endpoint *CompiledEndpoint, rqBody any, rsBody any, t
 		}
 	}
 
-	rs, err := c.HTTPClient().Do(rq)
-	if err != nil {
-		_ = c.RateLimiter().UnlockBucket(endpoint, nil)
-		return fmt.Errorf("error doing request in rest client: %w", err)
+	rt := &RoundTrip{
+		Endpoint: endpoint,
+		Bucket:   endpoint.BucketKey(),
+		Attempt:  tries + attempt - 1,
+		Request:  rq,
+		RqBody:   rawRqBody,
 	}
+	// only transport errors of the actual round trip are transient, errors returned by a Middleware are not retried
+	var transportErr error
+	middlewares := append(append(Middlewares{}, c.config.Middlewares...), cfg.Middlewares...)
+	err = middlewares.Then(func(rt *RoundTrip) error {
+		err := c.roundTrip(rt)
+		if rt.Response == nil {
+			transportErr = err
+		}
+		return err
+	})(rt)
 
-	if err = c.RateLimiter().UnlockBucket(endpoint, rs); err != nil {
-		return fmt.Errorf("error unlocking bucket in rest client: %w", err)
+	if rt.Response == nil {
+		_ = c.RateLimiter().UnlockBucket(endpoint, nil)
+		if err == nil {
+			return ErrNoResponse
+		}
+		if transportErr != nil && errors.Is(err, transportErr) && c.config.RetryPolicy.ShouldRetry(attempt, idempotent, nil, err) {
+			return c.retryTransient(cfg.Ctx, endpoint, rqBody, rsBody, tries, attempt, opts, err)
+		}
+		return err
 	}
 
-	var rawRsBody []byte
-	if rs.Body != nil {
-		if rawRsBody, err = io.ReadAll(rs.Body); err != nil {
-			return fmt.Errorf("error reading response body in rest client: %w", err)
-		}
-		c.config.Logger.Debug("new response", slog.String("endpoint", endpoint.URL), slog.String("code", rs.Status), slog.String("body", string(rawRsBody)))
+	if unlockErr := c.RateLimiter().UnlockBucket(endpoint, rt.Response); unlockErr != nil {
+		return fmt.Errorf("error unlocking bucket in rest client: %w", unlockErr)
 	}
 
-	switch rs.StatusCode {
+	switch rt.Response.StatusCode {
 	case http.StatusOK, http.StatusCreated, http.StatusNoContent:
-		if rsBody != nil && rs.Body != nil {
-			if err = json.Unmarshal(rawRsBody, rsBody); err != nil {
-				c.config.Logger.Error("error unmarshalling response body", slog.Any("err", err), slog.String("endpoint", endpoint.URL), slog.String("code", rs.Status), slog.String("body", string(rawRsBody)))
+		if err != nil {
+			return err
+		}
+		if rsBody != nil && rt.Response.Body != nil {
+			if err = json.Unmarshal(rt.RsBody, rsBody); err != nil {
+				c.config.Logger.Error("error unmarshalling response body", slog.Any("err", err), slog.String("endpoint", endpoint.URL), slog.String("code", rt.Response.Status), slog.String("body", string(rt.RsBody)))
 				return fmt.Errorf("error unmarshalling response body: %w", err)
 			}
 		}
@@ -157,15 +206,110 @@ func (c *clientImpl) retry(endpoint *CompiledEndpoint, rqBody any, rsBody any, t
 
 	case http.StatusTooManyRequests:
 		if tries >= c.RateLimiter().MaxRetries() {
-			return NewError(rq, rawRqBody, rs, rawRsBody)
+			return NewError(rt.Request, rt.RqBody, rt.Response, rt.RsBody)
+		}
+		return c.retry(endpoint, rqBody, rsBody, tries+1, attempt, opts)
+
+	default:
+		// a Middleware can set a non 2xx response without returning an error, so the error is always built from the response
+		rsErr := NewError(rt.Request, rt.RqBody, rt.Response, rt.RsBody)
+		if c.config.RetryPolicy.ShouldRetry(attempt, idempotent, rt.Response, nil) {
+			return c.retryTransient(cfg.Ctx, endpoint, rqBody, rsBody, tries, attempt, opts, rsErr)
+		}
+		return rsErr
+	}
+}
+
+// roundTrip is the innermost RoundTripFunc of the Middleware chain which actually sends the request
+func (c *clientImpl) roundTrip(rt *RoundTrip) error {
+	rs, err := c.HTTPClient().Do(rt.Request)
+	if err != nil {
+		return fmt.Errorf("error doing request in rest client: %w", err)
+	}
+	rt.Response = rs
+
+	if rs.Body != nil {
+		defer func() {
+			_ = rs.Body.Close()
+		}()
+		if rt.RsBody, err = io.ReadAll(rs.Body); err != nil {
+			return fmt.Errorf("error reading response body in rest client: %w", err)
 		}
-		return c.retry(endpoint, rqBody, rsBody, tries+1, opts)
+		c.config.Logger.Debug("new response", slog.String("endpoint", rt.Endpoint.URL), slog.String("code", rs.Status), slog.String("body", string(rt.RsBody)))
+	}
 
+	switch rs.StatusCode {
+	case http.StatusOK, http.StatusCreated, http.StatusNoContent:
+		return nil
 	default:
-		return NewError(rq, rawRqBody, rs, rawRsBody)
+		return NewError(rt.Request, rt.RqBody, rs, rt.RsBody)
 	}
 }
 
+func (c *clientImpl) retryTransient(ctx context.Context, endpoint *CompiledEndpoint, rqBody any, rsBody any, tries int, attempt int, opts []RequestOpt, cause error) error {
+	delay := c.config.RetryPolicy.Backoff(attempt)
+	c.config.Logger.WarnContext(ctx, "retrying request after transient error",
+		slog.String("method", endpoint.Endpoint.Method),
+		slog.String("endpoint", endpoint.URL),
+		slog.Int("attempt", attempt),
+		slog.Int("max_attempts", c.config.RetryPolicy.MaxAttempts),
+		slog.Duration("delay", delay),
+		slog.Any("err", cause),
+	)
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+	}
+	return c.retry(endpoint, rqBody, rsBody, tries, attempt+1, opts)
+}
+
 func (c *clientImpl) Do(endpoint *CompiledEndpoint, rqBody any, rsBody any, opts ...RequestOpt) error {
-	return c.retry(endpoint, rqBody, rsBody, 1, opts)
+	if multipart, ok := rqBody.(*discord.MultipartBody); ok {
+		// removes temporary files of spooled uploads once all retries are done or the payload is invalid
+		defer func() {
+			_ = multipart.Close()
+		}()
+	}
+	if c.config.ValidatePayloads {
+		if err := validatePayload(rqBody); err != nil {
+			return err
+		}
+	}
+	return c.retry(endpoint, rqBody, rsBody, 1, 1, opts)
+}
+
+// validatePayload validates the request body if it or the payload of a multipart body implements discord.Validator
+func validatePayload(rqBody any) error {
+	switch v := rqBody.(type) {
+	case *discord.MultipartBody:
+		rqBody = v.Payload
+	case *discord.MultipartBuffer:
+		rqBody = v.Payload
+	}
+	switch v := rqBody.(type) {
+	case discord.Validator:
+		return v.Validate()
+	case []discord.ApplicationCommandCreate:
+		var errs discord.ValidationErrors
+		for i, command := range v {
+			if err := command.Validate(); err != nil {
+				var commandErrs discord.ValidationErrors
+				if !errors.As(err, &commandErrs) {
+					return err
+				}
+				for _, commandErr := range commandErrs {
+					commandErr.Path = strings.TrimSuffix(strconv.Itoa(i)+"."+commandErr.Path, ".")
+					errs = append(errs, commandErr)
+				}
+			}
+		}
+		if len(errs) > 0 {
+			return errs
+		}
+	}
+	return nil
 }
@@ -0,0 +1,100 @@
+package rest
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/disgoorg/disgo/discord"
+)
+
+func newValidationTestClient(t *testing.T, validate bool) (Client, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "/commands") {
+			_, _ = w.Write([]byte(`[]`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"1","channel_id":"2"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	return NewClient("", WithURL(srv.URL), WithRateLimiter(NewNoopRateLimiter()), WithPayloadValidation(validate)), &calls
+}
+
+func TestClient_PayloadValidation(t *testing.T) {
+	t.Parallel()
+
+	client, calls := newValidationTestClient(t, true)
+	channels := NewChannels(client)
+	var errs discord.ValidationErrors
+
+	_, err := channels.CreateMessage(2, discord.MessageCreate{Embeds: make([]discord.Embed, 11)})
+	require.True(t, errors.As(err, &errs))
+	assert.Len(t, errs.Get("embeds"), 1)
+
+	// the payload of multipart bodies is validated as well
+	_, err = channels.CreateMessage(2, discord.MessageCreate{
+		Content: strings.Repeat("a", 2001),
+		Files:   []*discord.File{discord.NewFile("a.txt", "", strings.NewReader("a"))},
+	})
+	require.True(t, errors.As(err, &errs))
+	assert.Len(t, errs.Get("content"), 1)
+
+	_, err = NewApplications(client).SetGlobalCommands(1, []discord.ApplicationCommandCreate{
+		discord.SlashCommandCreate{Name: "valid", Description: "valid"},
+		discord.SlashCommandCreate{Name: "Invalid", Description: "invalid"},
+	})
+	require.True(t, errors.As(err, &errs))
+	assert.Len(t, errs.Get("1.name"), 1)
+	assert.Equal(t, int32(0), calls.Load())
+
+	_, err = channels.CreateMessage(2, discord.MessageCreate{Content: "hello"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestClient_PayloadValidationClosesMultipartBody(t *testing.T) {
+	t.Parallel()
+
+	client, calls := newValidationTestClient(t, true)
+	// non-seekable files bigger than 1 MiB are spooled to a temporary file
+	body, err := discord.MessageCreate{
+		Content: strings.Repeat("a", 2001),
+		Files:   []*discord.File{discord.NewFile("a.txt", "", io.MultiReader(bytes.NewReader(make([]byte, 2<<20))))},
+	}.ToBody()
+	require.NoError(t, err)
+
+	var errs discord.ValidationErrors
+	err = client.Do(CreateMessage.Compile(nil, 2), body, nil)
+	require.True(t, errors.As(err, &errs))
+	assert.Equal(t, int32(0), calls.Load())
+
+	// the temporary file is closed and removed even though the request was never sent
+	reader, err := body.(*discord.MultipartBody).Reader()
+	require.NoError(t, err)
+	_, err = io.Copy(io.Discard, reader)
+	assert.ErrorIs(t, err, os.ErrClosed)
+}
+
+func TestClient_PayloadValidationDisabled(t *testing.T) {
+	t.Parallel()
+
+	client, calls := newValidationTestClient(t, false)
+	_, err := NewChannels(client).CreateMessage(2, discord.MessageCreate{Embeds: make([]discord.Embed, 11)})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), calls.Load())
+}
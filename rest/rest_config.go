@@ -9,9 +9,10 @@ import (
 
 func defaultConfig() config {
 	return config{
-		Logger:     slog.Default(),
-		HTTPClient: &http.Client{Timeout: 20 * time.Second},
-		URL:        fmt.Sprintf("%sv%d", API, Version),
+		Logger:      slog.Default(),
+		HTTPClient:  &http.Client{Timeout: 20 * time.Second},
+		URL:         fmt.Sprintf("%sv%d", API, Version),
+		RetryPolicy: DefaultRetryPolicy(),
 	}
 }
 
@@ -22,6 +23,10 @@ type config struct {
 	RateLimiterConfigOpts []RateLimiterConfigOpt
 	URL                   string
 	UserAgent             string
+	RetryPolicy           RetryPolicy
+	Middlewares           Middlewares
+	ValidatePayloads      bool
+	VCR                   *VCR
 }
 
 // ConfigOpt can be used to supply optional parameters to NewClient
@@ -35,6 +40,15 @@ func (c *config) apply(opts []ConfigOpt) {
 	if c.RateLimiter == nil {
 		c.RateLimiter = NewRateLimiter(c.RateLimiterConfigOpts...)
 	}
+	if c.VCR != nil {
+		// wrap a copy of the http.Client, so a shared client is not affected
+		httpClient := *c.HTTPClient
+		if c.VCR.transport == nil {
+			c.VCR.transport = httpClient.Transport
+		}
+		httpClient.Transport = c.VCR
+		c.HTTPClient = &httpClient
+	}
 }
 
 // WithLogger applies a custom logger to the rest rate limiter
@@ -85,3 +99,33 @@ func WithUserAgent(userAgent string) ConfigOpt {
 		config.UserAgent = userAgent
 	}
 }
+
+// WithRetryPolicy sets the RetryPolicy used to retry requests which failed due to transient errors
+func WithRetryPolicy(retryPolicy RetryPolicy) ConfigOpt {
+	return func(config *config) {
+		config.RetryPolicy = retryPolicy
+	}
+}
+
+// WithMiddlewares adds Middleware(s) which wrap every request made by the rest client
+func WithMiddlewares(middlewares ...Middleware) ConfigOpt {
+	return func(config *config) {
+		config.Middlewares = append(config.Middlewares, middlewares...)
+	}
+}
+
+// WithPayloadValidation validates request bodies implementing discord.Validator before they are sent.
+// Requests with invalid bodies fail with discord.ValidationErrors instead of a 400 response from Discord.
+func WithPayloadValidation(validate bool) ConfigOpt {
+	return func(config *config) {
+		config.ValidatePayloads = validate
+	}
+}
+
+// WithVCR records all HTTP interactions of the rest client to the VCR's Cassette or replays them from it, depending on its VCRMode.
+// This allows testing code using the rest client and the RateLimiter offline.
+func WithVCR(vcr *VCR) ConfigOpt {
+	return func(config *config) {
+		config.VCR = vcr
+	}
+}
@@ -215,6 +215,8 @@ var (
 	DeleteMessage      = NewEndpoint(http.MethodDelete, "/channels/{channel.id}/messages/{message.id}")
 	BulkDeleteMessages = NewEndpoint(http.MethodPost, "/channels/{channel.id}/messages/bulk-delete")
 
+	CreateAttachmentUploads = NewEndpoint(http.MethodPost, "/channels/{channel.id}/attachments")
+
 	// Deprecated: Use GetChannelPins instead
 	GetPinnedMessages = NewEndpoint(http.MethodGet, "/channels/{channel.id}/pins")
 
@@ -339,6 +341,22 @@ var (
 	GetSKUSubscription  = NewEndpoint(http.MethodGet, "/skus/{sku.id}/subscriptions/{subscription.id}")
 )
 
+// Lobbies
+var (
+	CreateLobby       = NewEndpoint(http.MethodPost, "/lobbies")
+	GetLobby          = NewEndpoint(http.MethodGet, "/lobbies/{lobby.id}")
+	UpdateLobby       = NewEndpoint(http.MethodPatch, "/lobbies/{lobby.id}")
+	DeleteLobby       = NewEndpoint(http.MethodDelete, "/lobbies/{lobby.id}")
+	AddLobbyMember    = NewEndpoint(http.MethodPut, "/lobbies/{lobby.id}/members/{user.id}")
+	RemoveLobbyMember = NewEndpoint(http.MethodDelete, "/lobbies/{lobby.id}/members/{user.id}")
+
+	JoinOrCreateLobby = NewNoBotAuthEndpoint(http.MethodPut, "/lobbies")
+	LeaveLobby        = NewNoBotAuthEndpoint(http.MethodDelete, "/lobbies/{lobby.id}/members/@me")
+	LinkLobbyChannel  = NewNoBotAuthEndpoint(http.MethodPatch, "/lobbies/{lobby.id}/channel-linking")
+
+	CreateLobbyMessage = NewEndpoint(http.MethodPost, "/lobbies/{lobby.id}/messages")
+)
+
 // NewEndpoint returns a new Endpoint which requires bot auth with the given http method & route.
 func NewEndpoint(method string, route string) *Endpoint {
 	return &Endpoint{
@@ -367,11 +385,24 @@ type Endpoint struct {
 // CompiledEndpoint represents a Discord Rest API endpoint with applied url params & query values.
 type CompiledEndpoint struct {
 	Endpoint *Endpoint
+	// Params are the url params the Endpoint was compiled with
+	Params []any
+	// Query are the query values the Endpoint was compiled with
+	Query discord.QueryValues
 
 	URL         string
 	MajorParams string
 }
 
+// BucketKey returns the key used to group requests to this CompiledEndpoint into rate limit buckets.
+func (e *CompiledEndpoint) BucketKey() string {
+	key := e.Endpoint.Method + "+" + e.Endpoint.Route
+	if e.MajorParams != "" {
+		key += "+" + e.MajorParams
+	}
+	return key
+}
+
 // Compile compiles an Endpoint to a CompiledEndpoint with the given url params & query values
 func (e *Endpoint) Compile(values discord.QueryValues, params ...any) *CompiledEndpoint {
 	var majorParams []string
@@ -397,6 +428,8 @@ func (e *Endpoint) Compile(values discord.QueryValues, params ...any) *CompiledE
 
 	return &CompiledEndpoint{
 		Endpoint:    e,
+		Params:      params,
+		Query:       values,
 		URL:         path + query,
 		MajorParams: strings.Join(majorParams, ":"),
 	}
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
+	"strings"
 
 	"github.com/disgoorg/json/v2"
 )
@@ -12,6 +14,26 @@ import (
 // See https://discord.com/developers/docs/topics/opcodes-and-status-codes#json-json-error-codes
 type JSONErrorCode int
 
+var _ error = JSONErrorCode(0)
+
+// Message returns the documented message of the JSONErrorCode or an empty string if the code is unknown.
+func (c JSONErrorCode) Message() string {
+	return jsonErrorCodeMessages[c]
+}
+
+// Error returns the JSONErrorCode formatted as string
+func (c JSONErrorCode) Error() string {
+	if message := c.Message(); message != "" {
+		return fmt.Sprintf("%d: %s", c, message)
+	}
+	return fmt.Sprintf("%d: Unknown JSON error code", c)
+}
+
+// String returns the JSONErrorCode formatted as string
+func (c JSONErrorCode) String() string {
+	return c.Error()
+}
+
 var _ error = (*Error)(nil)
 
 // Error holds the http.Response & an error related to a REST request
@@ -24,6 +46,9 @@ type Error struct {
 	Code    JSONErrorCode   `json:"code"`
 	Errors  json.RawMessage `json:"errors"`
 	Message string          `json:"message"`
+
+	// decoded is true if the response body contained a JSON error code, which can be JSONErrorCodeGeneral
+	decoded bool
 }
 
 // NewError returns a new Error with the given http.Request, http.Response
@@ -31,6 +56,11 @@ func NewError(rq *http.Request, rqBody []byte, rs *http.Response, rsBody []byte)
 	var err Error
 	_ = json.Unmarshal(rsBody, &err)
 
+	var v struct {
+		Code *JSONErrorCode `json:"code"`
+	}
+	err.decoded = json.Unmarshal(rsBody, &v) == nil && v.Code != nil
+
 	err.Request = rq
 	err.RqBody = rqBody
 	err.Response = rs
@@ -39,22 +69,55 @@ func NewError(rq *http.Request, rqBody []byte, rs *http.Response, rsBody []byte)
 	return err
 }
 
-// Is returns true if the error is a *Error with the same status code as the target error
+// Is returns true if the error is a *Error with the same status code as the target error or if the target is the same JSONErrorCode
 func (e Error) Is(target error) bool {
+	var code JSONErrorCode
+	if errors.As(target, &code) {
+		return e.hasCode() && e.Code == code
+	}
+
 	var err *Error
 	if ok := errors.As(target, &err); !ok {
 		return false
 	}
-	if e.Code != 0 && err.Code != 0 {
+	if e.hasCode() && err.hasCode() {
 		return e.Code == err.Code
 	}
 	return err.Response != nil && e.Response != nil && err.Response.StatusCode == e.Response.StatusCode
 }
 
+// hasCode returns true if the Error has a JSONErrorCode, either decoded from the response body or set manually
+func (e Error) hasCode() bool {
+	return e.decoded || e.Code != 0
+}
+
+// FieldErrors parses the field-level validation errors of the Error.
+// It returns nil if the Error has no field-level validation errors.
+func (e Error) FieldErrors() *FieldErrors {
+	if len(e.Errors) == 0 {
+		return nil
+	}
+	var fieldErrors FieldErrors
+	if err := json.Unmarshal(e.Errors, &fieldErrors); err != nil {
+		return nil
+	}
+	return &fieldErrors
+}
+
 // Error returns the error formatted as string
 func (e Error) Error() string {
-	if e.Code != 0 {
-		return fmt.Sprintf("%d: %s", e.Code, e.Message)
+	if e.hasCode() {
+		msg := fmt.Sprintf("%d: %s", e.Code, e.Message)
+		if fieldErrors := e.FieldErrors(); fieldErrors != nil {
+			var errs []string
+			for _, fieldError := range fieldErrors.All() {
+				errs = append(errs, fieldError.Error())
+			}
+			if len(errs) > 0 {
+				msg += " (" + strings.Join(errs, ", ") + ")"
+			}
+		}
+		return msg
 	}
 	return fmt.Sprintf("Status: %s, Body: %s", e.Response.Status, string(e.RsBody))
 }
@@ -63,3 +126,93 @@ func (e Error) Error() string {
 func (e Error) String() string {
 	return e.Error()
 }
+
+// FieldErrors is a tree of field-level validation errors returned by the Discord API.
+// Each node holds the errors of its field and the errors of its nested fields. Array indices are used as field names.
+type FieldErrors struct {
+	// Errors are the errors of this field
+	Errors []FieldError
+	// Fields are the nested fields by their name or array index
+	Fields map[string]*FieldErrors
+}
+
+// UnmarshalJSON implements json.Unmarshaler for the nested `_errors` format of the Discord API
+func (e *FieldErrors) UnmarshalJSON(data []byte) error {
+	var v map[string]json.RawMessage
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	for key, value := range v {
+		if key == "_errors" {
+			if err := json.Unmarshal(value, &e.Errors); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var fieldErrors FieldErrors
+		if err := json.Unmarshal(value, &fieldErrors); err != nil {
+			return err
+		}
+		if e.Fields == nil {
+			e.Fields = map[string]*FieldErrors{}
+		}
+		e.Fields[key] = &fieldErrors
+	}
+	return nil
+}
+
+// Get returns the FieldErrors of the field at the given dot separated path like `embeds.0.fields.2.value` or nil if there are none
+func (e *FieldErrors) Get(path string) *FieldErrors {
+	current := e
+	for _, name := range strings.Split(path, ".") {
+		if current == nil {
+			return nil
+		}
+		current = current.Fields[name]
+	}
+	return current
+}
+
+// All returns all FieldError(s) in the tree with their FieldError.Path set, sorted by path
+func (e *FieldErrors) All() []FieldError {
+	var all []FieldError
+	e.collect("", &all)
+	slices.SortStableFunc(all, func(a FieldError, b FieldError) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+	return all
+}
+
+func (e *FieldErrors) collect(path string, all *[]FieldError) {
+	for _, fieldError := range e.Errors {
+		fieldError.Path = path
+		*all = append(*all, fieldError)
+	}
+	for name, fieldErrors := range e.Fields {
+		fieldPath := name
+		if path != "" {
+			fieldPath = path + "." + name
+		}
+		fieldErrors.collect(fieldPath, all)
+	}
+}
+
+var _ error = (*FieldError)(nil)
+
+// FieldError is a single field-level validation error like `BASE_TYPE_MAX_LENGTH`
+type FieldError struct {
+	// Path is the dot separated path of the field like `embeds.0.fields.2.value`. It is only set by FieldErrors.All
+	Path    string `json:"-"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// Error returns the FieldError formatted as string
+func (e FieldError) Error() string {
+	if e.Path == "" {
+		return fmt.Sprintf("%s: %s", e.Code, e.Message)
+	}
+	return fmt.Sprintf("%s: %s: %s", e.Path, e.Code, e.Message)
+}
@@ -0,0 +1,456 @@
+package rest
+
+// JSON error codes returned by the Discord API.
+// JSONErrorCode implements error, so they can be used with errors.Is to check for a specific Error.
+// See https://discord.com/developers/docs/topics/opcodes-and-status-codes#json-json-error-codes
+const (
+	JSONErrorCodeGeneral                                  JSONErrorCode = 0
+	JSONErrorCodeUnknownAccount                           JSONErrorCode = 10001
+	JSONErrorCodeUnknownApplication                       JSONErrorCode = 10002
+	JSONErrorCodeUnknownChannel                           JSONErrorCode = 10003
+	JSONErrorCodeUnknownGuild                             JSONErrorCode = 10004
+	JSONErrorCodeUnknownIntegration                       JSONErrorCode = 10005
+	JSONErrorCodeUnknownInvite                            JSONErrorCode = 10006
+	JSONErrorCodeUnknownMember                            JSONErrorCode = 10007
+	JSONErrorCodeUnknownMessage                           JSONErrorCode = 10008
+	JSONErrorCodeUnknownPermissionOverwrite               JSONErrorCode = 10009
+	JSONErrorCodeUnknownProvider                          JSONErrorCode = 10010
+	JSONErrorCodeUnknownRole                              JSONErrorCode = 10011
+	JSONErrorCodeUnknownToken                             JSONErrorCode = 10012
+	JSONErrorCodeUnknownUser                              JSONErrorCode = 10013
+	JSONErrorCodeUnknownEmoji                             JSONErrorCode = 10014
+	JSONErrorCodeUnknownWebhook                           JSONErrorCode = 10015
+	JSONErrorCodeUnknownWebhookService                    JSONErrorCode = 10016
+	JSONErrorCodeUnknownSession                           JSONErrorCode = 10020
+	JSONErrorCodeUnknownAsset                             JSONErrorCode = 10021
+	JSONErrorCodeUnknownBan                               JSONErrorCode = 10026
+	JSONErrorCodeUnknownSKU                               JSONErrorCode = 10027
+	JSONErrorCodeUnknownStoreListing                      JSONErrorCode = 10028
+	JSONErrorCodeUnknownEntitlement                       JSONErrorCode = 10029
+	JSONErrorCodeUnknownBuild                             JSONErrorCode = 10030
+	JSONErrorCodeUnknownLobby                             JSONErrorCode = 10031
+	JSONErrorCodeUnknownBranch                            JSONErrorCode = 10032
+	JSONErrorCodeUnknownStoreDirectoryLayout              JSONErrorCode = 10033
+	JSONErrorCodeUnknownRedistributable                   JSONErrorCode = 10036
+	JSONErrorCodeUnknownGiftCode                          JSONErrorCode = 10038
+	JSONErrorCodeUnknownStream                            JSONErrorCode = 10049
+	JSONErrorCodeUnknownPremiumServerSubscribeCooldown    JSONErrorCode = 10050
+	JSONErrorCodeUnknownGuildTemplate                     JSONErrorCode = 10057
+	JSONErrorCodeUnknownDiscoverableServerCategory        JSONErrorCode = 10059
+	JSONErrorCodeUnknownSticker                           JSONErrorCode = 10060
+	JSONErrorCodeUnknownStickerPack                       JSONErrorCode = 10061
+	JSONErrorCodeUnknownInteraction                       JSONErrorCode = 10062
+	JSONErrorCodeUnknownApplicationCommand                JSONErrorCode = 10063
+	JSONErrorCodeUnknownVoiceState                        JSONErrorCode = 10065
+	JSONErrorCodeUnknownApplicationCommandPermissions     JSONErrorCode = 10066
+	JSONErrorCodeUnknownStageInstance                     JSONErrorCode = 10067
+	JSONErrorCodeUnknownGuildMemberVerificationForm       JSONErrorCode = 10068
+	JSONErrorCodeUnknownGuildWelcomeScreen                JSONErrorCode = 10069
+	JSONErrorCodeUnknownGuildScheduledEvent               JSONErrorCode = 10070
+	JSONErrorCodeUnknownGuildScheduledEventUser           JSONErrorCode = 10071
+	JSONErrorCodeUnknownTag                               JSONErrorCode = 10087
+	JSONErrorCodeUnknownSound                             JSONErrorCode = 10097
+	JSONErrorCodeBotsCannotUseEndpoint                    JSONErrorCode = 20001
+	JSONErrorCodeOnlyBotsCanUseEndpoint                   JSONErrorCode = 20002
+	JSONErrorCodeExplicitContentCannotBeSent              JSONErrorCode = 20009
+	JSONErrorCodeNotAuthorizedForApplication              JSONErrorCode = 20012
+	JSONErrorCodeSlowmodeRateLimit                        JSONErrorCode = 20016
+	JSONErrorCodeOnlyAccountOwner                         JSONErrorCode = 20018
+	JSONErrorCodeAnnouncementRateLimit                    JSONErrorCode = 20022
+	JSONErrorCodeUnderMinimumAge                          JSONErrorCode = 20024
+	JSONErrorCodeChannelWriteRateLimit                    JSONErrorCode = 20028
+	JSONErrorCodeServerWriteRateLimit                     JSONErrorCode = 20029
+	JSONErrorCodeWordsNotAllowed                          JSONErrorCode = 20031
+	JSONErrorCodeGuildPremiumLevelTooLow                  JSONErrorCode = 20035
+	JSONErrorCodeMaxGuilds                                JSONErrorCode = 30001
+	JSONErrorCodeMaxFriends                               JSONErrorCode = 30002
+	JSONErrorCodeMaxPins                                  JSONErrorCode = 30003
+	JSONErrorCodeMaxRecipients                            JSONErrorCode = 30004
+	JSONErrorCodeMaxGuildRoles                            JSONErrorCode = 30005
+	JSONErrorCodeMaxWebhooks                              JSONErrorCode = 30007
+	JSONErrorCodeMaxEmojis                                JSONErrorCode = 30008
+	JSONErrorCodeMaxReactions                             JSONErrorCode = 30010
+	JSONErrorCodeMaxGroupDMs                              JSONErrorCode = 30011
+	JSONErrorCodeMaxGuildChannels                         JSONErrorCode = 30013
+	JSONErrorCodeMaxAttachments                           JSONErrorCode = 30015
+	JSONErrorCodeMaxInvites                               JSONErrorCode = 30016
+	JSONErrorCodeMaxAnimatedEmojis                        JSONErrorCode = 30018
+	JSONErrorCodeMaxServerMembers                         JSONErrorCode = 30019
+	JSONErrorCodeMaxServerCategories                      JSONErrorCode = 30030
+	JSONErrorCodeGuildAlreadyHasTemplate                  JSONErrorCode = 30031
+	JSONErrorCodeMaxApplicationCommands                   JSONErrorCode = 30032
+	JSONErrorCodeMaxThreadParticipants                    JSONErrorCode = 30033
+	JSONErrorCodeMaxDailyApplicationCommandCreates        JSONErrorCode = 30034
+	JSONErrorCodeMaxNonMemberBans                         JSONErrorCode = 30035
+	JSONErrorCodeMaxBanFetches                            JSONErrorCode = 30037
+	JSONErrorCodeMaxUncompletedGuildScheduledEvents       JSONErrorCode = 30038
+	JSONErrorCodeMaxStickers                              JSONErrorCode = 30039
+	JSONErrorCodeMaxPruneRequests                         JSONErrorCode = 30040
+	JSONErrorCodeMaxGuildWidgetSettingsUpdates            JSONErrorCode = 30042
+	JSONErrorCodeMaxSoundboardSounds                      JSONErrorCode = 30045
+	JSONErrorCodeMaxOldMessageEdits                       JSONErrorCode = 30046
+	JSONErrorCodeMaxPinnedForumThreads                    JSONErrorCode = 30047
+	JSONErrorCodeMaxForumTags                             JSONErrorCode = 30048
+	JSONErrorCodeBitrateTooHigh                           JSONErrorCode = 30052
+	JSONErrorCodeMaxPremiumEmojis                         JSONErrorCode = 30056
+	JSONErrorCodeMaxGuildWebhooks                         JSONErrorCode = 30058
+	JSONErrorCodeMaxChannelPermissionOverwrites           JSONErrorCode = 30060
+	JSONErrorCodeGuildChannelsTooLarge                    JSONErrorCode = 30061
+	JSONErrorCodeUnauthorized                             JSONErrorCode = 40001
+	JSONErrorCodeAccountVerificationRequired              JSONErrorCode = 40002
+	JSONErrorCodeOpeningDMsTooFast                        JSONErrorCode = 40003
+	JSONErrorCodeSendMessagesTemporarilyDisabled          JSONErrorCode = 40004
+	JSONErrorCodeRequestEntityTooLarge                    JSONErrorCode = 40005
+	JSONErrorCodeFeatureTemporarilyDisabled               JSONErrorCode = 40006
+	JSONErrorCodeUserBannedFromGuild                      JSONErrorCode = 40007
+	JSONErrorCodeConnectionRevoked                        JSONErrorCode = 40012
+	JSONErrorCodeOnlyConsumableSKUs                       JSONErrorCode = 40018
+	JSONErrorCodeOnlySandboxEntitlements                  JSONErrorCode = 40019
+	JSONErrorCodeTargetUserNotInVoice                     JSONErrorCode = 40032
+	JSONErrorCodeMessageAlreadyCrossposted                JSONErrorCode = 40033
+	JSONErrorCodeApplicationCommandNameExists             JSONErrorCode = 40041
+	JSONErrorCodeApplicationInteractionFailed             JSONErrorCode = 40043
+	JSONErrorCodeCannotSendInForumChannel                 JSONErrorCode = 40058
+	JSONErrorCodeInteractionAlreadyAcknowledged           JSONErrorCode = 40060
+	JSONErrorCodeTagNamesMustBeUnique                     JSONErrorCode = 40061
+	JSONErrorCodeServiceResourceRateLimited               JSONErrorCode = 40062
+	JSONErrorCodeNoTagsAvailable                          JSONErrorCode = 40066
+	JSONErrorCodeTagRequired                              JSONErrorCode = 40067
+	JSONErrorCodeEntitlementAlreadyGranted                JSONErrorCode = 40074
+	JSONErrorCodeMaxFollowupMessages                      JSONErrorCode = 40094
+	JSONErrorCodeCloudflareBlocked                        JSONErrorCode = 40333
+	JSONErrorCodeMissingAccess                            JSONErrorCode = 50001
+	JSONErrorCodeInvalidAccountType                       JSONErrorCode = 50002
+	JSONErrorCodeCannotExecuteOnDMChannel                 JSONErrorCode = 50003
+	JSONErrorCodeGuildWidgetDisabled                      JSONErrorCode = 50004
+	JSONErrorCodeCannotEditOtherUsersMessage              JSONErrorCode = 50005
+	JSONErrorCodeCannotSendEmptyMessage                   JSONErrorCode = 50006
+	JSONErrorCodeCannotSendMessagesToUser                 JSONErrorCode = 50007
+	JSONErrorCodeCannotSendMessagesInNonTextChannel       JSONErrorCode = 50008
+	JSONErrorCodeChannelVerificationLevelTooHigh          JSONErrorCode = 50009
+	JSONErrorCodeOAuth2ApplicationNoBot                   JSONErrorCode = 50010
+	JSONErrorCodeOAuth2ApplicationLimitReached            JSONErrorCode = 50011
+	JSONErrorCodeInvalidOAuth2State                       JSONErrorCode = 50012
+	JSONErrorCodeMissingPermissions                       JSONErrorCode = 50013
+	JSONErrorCodeInvalidAuthenticationToken               JSONErrorCode = 50014
+	JSONErrorCodeNoteTooLong                              JSONErrorCode = 50015
+	JSONErrorCodeInvalidBulkDeleteMessageCount            JSONErrorCode = 50016
+	JSONErrorCodeInvalidMFALevel                          JSONErrorCode = 50017
+	JSONErrorCodePinInWrongChannel                        JSONErrorCode = 50019
+	JSONErrorCodeInvalidInviteCode                        JSONErrorCode = 50020
+	JSONErrorCodeCannotExecuteOnSystemMessage             JSONErrorCode = 50021
+	JSONErrorCodeCannotExecuteOnChannelType               JSONErrorCode = 50024
+	JSONErrorCodeInvalidOAuth2AccessToken                 JSONErrorCode = 50025
+	JSONErrorCodeMissingOAuth2Scope                       JSONErrorCode = 50026
+	JSONErrorCodeInvalidWebhookToken                      JSONErrorCode = 50027
+	JSONErrorCodeInvalidRole                              JSONErrorCode = 50028
+	JSONErrorCodeInvalidRecipients                        JSONErrorCode = 50033
+	JSONErrorCodeMessageTooOldToBulkDelete                JSONErrorCode = 50034
+	JSONErrorCodeInvalidFormBody                          JSONErrorCode = 50035
+	JSONErrorCodeInviteAcceptedToGuildWithoutBot          JSONErrorCode = 50036
+	JSONErrorCodeInvalidActivityAction                    JSONErrorCode = 50039
+	JSONErrorCodeInvalidAPIVersion                        JSONErrorCode = 50041
+	JSONErrorCodeFileTooLarge                             JSONErrorCode = 50045
+	JSONErrorCodeInvalidFileUploaded                      JSONErrorCode = 50046
+	JSONErrorCodeCannotSelfRedeemGift                     JSONErrorCode = 50054
+	JSONErrorCodeInvalidGuild                             JSONErrorCode = 50055
+	JSONErrorCodeInvalidSKU                               JSONErrorCode = 50057
+	JSONErrorCodeInvalidRequestOrigin                     JSONErrorCode = 50067
+	JSONErrorCodeInvalidMessageType                       JSONErrorCode = 50068
+	JSONErrorCodePaymentSourceRequired                    JSONErrorCode = 50070
+	JSONErrorCodeCannotModifySystemWebhook                JSONErrorCode = 50073
+	JSONErrorCodeCannotDeleteCommunityChannel             JSONErrorCode = 50074
+	JSONErrorCodeCannotEditMessageStickers                JSONErrorCode = 50080
+	JSONErrorCodeInvalidSticker                           JSONErrorCode = 50081
+	JSONErrorCodeThreadArchived                           JSONErrorCode = 50083
+	JSONErrorCodeInvalidThreadNotificationSettings        JSONErrorCode = 50084
+	JSONErrorCodeBeforeEarlierThanThreadCreation          JSONErrorCode = 50085
+	JSONErrorCodeCommunityChannelsMustBeText              JSONErrorCode = 50086
+	JSONErrorCodeEventEntityTypeMismatch                  JSONErrorCode = 50091
+	JSONErrorCodeServerNotAvailableInLocation             JSONErrorCode = 50095
+	JSONErrorCodeMonetizationRequired                     JSONErrorCode = 50097
+	JSONErrorCodeMoreBoostsRequired                       JSONErrorCode = 50101
+	JSONErrorCodeInvalidJSON                              JSONErrorCode = 50109
+	JSONErrorCodeInvalidFile                              JSONErrorCode = 50110
+	JSONErrorCodeInvalidFileType                          JSONErrorCode = 50123
+	JSONErrorCodeFileDurationTooLong                      JSONErrorCode = 50124
+	JSONErrorCodeOwnerCannotBePending                     JSONErrorCode = 50131
+	JSONErrorCodeCannotTransferOwnershipToBot             JSONErrorCode = 50132
+	JSONErrorCodeFailedToResizeAsset                      JSONErrorCode = 50138
+	JSONErrorCodeCannotMixSubscriptionRoles               JSONErrorCode = 50144
+	JSONErrorCodeCannotConvertPremiumEmoji                JSONErrorCode = 50145
+	JSONErrorCodeUploadedFileNotFound                     JSONErrorCode = 50146
+	JSONErrorCodeInvalidEmoji                             JSONErrorCode = 50151
+	JSONErrorCodeVoiceMessagesNoAdditionalContent         JSONErrorCode = 50159
+	JSONErrorCodeVoiceMessagesSingleAudioAttachment       JSONErrorCode = 50160
+	JSONErrorCodeVoiceMessagesMetadataRequired            JSONErrorCode = 50161
+	JSONErrorCodeVoiceMessagesCannotBeEdited              JSONErrorCode = 50162
+	JSONErrorCodeCannotDeleteGuildSubscriptionIntegration JSONErrorCode = 50163
+	JSONErrorCodeCannotSendVoiceMessagesInChannel         JSONErrorCode = 50173
+	JSONErrorCodeUserAccountMustBeVerified                JSONErrorCode = 50178
+	JSONErrorCodeInvalidFileDuration                      JSONErrorCode = 50192
+	JSONErrorCodeNoPermissionToSendSticker                JSONErrorCode = 50600
+	JSONErrorCodeTwoFactorRequired                        JSONErrorCode = 60003
+	JSONErrorCodeNoUsersWithDiscordTag                    JSONErrorCode = 80004
+	JSONErrorCodeReactionBlocked                          JSONErrorCode = 90001
+	JSONErrorCodeCannotUseBurstReactions                  JSONErrorCode = 90002
+	JSONErrorCodeApplicationNotYetAvailable               JSONErrorCode = 110001
+	JSONErrorCodeAPIResourceOverloaded                    JSONErrorCode = 130000
+	JSONErrorCodeStageAlreadyOpen                         JSONErrorCode = 150006
+	JSONErrorCodeCannotReplyWithoutReadMessageHistory     JSONErrorCode = 160002
+	JSONErrorCodeThreadAlreadyCreatedForMessage           JSONErrorCode = 160004
+	JSONErrorCodeThreadLocked                             JSONErrorCode = 160005
+	JSONErrorCodeMaxActiveThreads                         JSONErrorCode = 160006
+	JSONErrorCodeMaxActiveAnnouncementThreads             JSONErrorCode = 160007
+	JSONErrorCodeInvalidLottieJSON                        JSONErrorCode = 170001
+	JSONErrorCodeLottieRasterizedImages                   JSONErrorCode = 170002
+	JSONErrorCodeStickerMaxFramerateExceeded              JSONErrorCode = 170003
+	JSONErrorCodeStickerMaxFrameCountExceeded             JSONErrorCode = 170004
+	JSONErrorCodeLottieMaxDimensionsExceeded              JSONErrorCode = 170005
+	JSONErrorCodeStickerInvalidFrameRate                  JSONErrorCode = 170006
+	JSONErrorCodeStickerAnimationDurationTooLong          JSONErrorCode = 170007
+	JSONErrorCodeCannotUpdateFinishedEvent                JSONErrorCode = 180000
+	JSONErrorCodeFailedToCreateStageForEvent              JSONErrorCode = 180002
+	JSONErrorCodeMessageBlockedByAutoModeration           JSONErrorCode = 200000
+	JSONErrorCodeTitleBlockedByAutoModeration             JSONErrorCode = 200001
+	JSONErrorCodeForumWebhookThreadRequired               JSONErrorCode = 220001
+	JSONErrorCodeForumWebhookThreadNameAndID              JSONErrorCode = 220002
+	JSONErrorCodeWebhookThreadsOnlyInForum                JSONErrorCode = 220003
+	JSONErrorCodeWebhookServicesNotInForum                JSONErrorCode = 220004
+	JSONErrorCodeMessageBlockedByHarmfulLinksFilter       JSONErrorCode = 240000
+	JSONErrorCodeCannotEnableOnboarding                   JSONErrorCode = 350000
+	JSONErrorCodeCannotUpdateOnboarding                   JSONErrorCode = 350001
+	JSONErrorCodeFailedToBanUsers                         JSONErrorCode = 500000
+	JSONErrorCodePollVotingBlocked                        JSONErrorCode = 520000
+	JSONErrorCodePollExpired                              JSONErrorCode = 520001
+	JSONErrorCodeInvalidPollChannelType                   JSONErrorCode = 520002
+	JSONErrorCodeCannotEditPollMessage                    JSONErrorCode = 520003
+	JSONErrorCodeCannotUsePollEmoji                       JSONErrorCode = 520004
+	JSONErrorCodeCannotExpireNonPollMessage               JSONErrorCode = 520006
+)
+
+var jsonErrorCodeMessages = map[JSONErrorCode]string{
+	JSONErrorCodeGeneral:                                  "General error (such as a malformed request body, amongst other things)",
+	JSONErrorCodeUnknownAccount:                           "Unknown account",
+	JSONErrorCodeUnknownApplication:                       "Unknown application",
+	JSONErrorCodeUnknownChannel:                           "Unknown channel",
+	JSONErrorCodeUnknownGuild:                             "Unknown guild",
+	JSONErrorCodeUnknownIntegration:                       "Unknown integration",
+	JSONErrorCodeUnknownInvite:                            "Unknown invite",
+	JSONErrorCodeUnknownMember:                            "Unknown member",
+	JSONErrorCodeUnknownMessage:                           "Unknown message",
+	JSONErrorCodeUnknownPermissionOverwrite:               "Unknown permission overwrite",
+	JSONErrorCodeUnknownProvider:                          "Unknown provider",
+	JSONErrorCodeUnknownRole:                              "Unknown role",
+	JSONErrorCodeUnknownToken:                             "Unknown token",
+	JSONErrorCodeUnknownUser:                              "Unknown user",
+	JSONErrorCodeUnknownEmoji:                             "Unknown emoji",
+	JSONErrorCodeUnknownWebhook:                           "Unknown webhook",
+	JSONErrorCodeUnknownWebhookService:                    "Unknown webhook service",
+	JSONErrorCodeUnknownSession:                           "Unknown session",
+	JSONErrorCodeUnknownAsset:                             "Unknown asset",
+	JSONErrorCodeUnknownBan:                               "Unknown ban",
+	JSONErrorCodeUnknownSKU:                               "Unknown SKU",
+	JSONErrorCodeUnknownStoreListing:                      "Unknown Store Listing",
+	JSONErrorCodeUnknownEntitlement:                       "Unknown entitlement",
+	JSONErrorCodeUnknownBuild:                             "Unknown build",
+	JSONErrorCodeUnknownLobby:                             "Unknown lobby",
+	JSONErrorCodeUnknownBranch:                            "Unknown branch",
+	JSONErrorCodeUnknownStoreDirectoryLayout:              "Unknown store directory layout",
+	JSONErrorCodeUnknownRedistributable:                   "Unknown redistributable",
+	JSONErrorCodeUnknownGiftCode:                          "Unknown gift code",
+	JSONErrorCodeUnknownStream:                            "Unknown stream",
+	JSONErrorCodeUnknownPremiumServerSubscribeCooldown:    "Unknown premium server subscribe cooldown",
+	JSONErrorCodeUnknownGuildTemplate:                     "Unknown guild template",
+	JSONErrorCodeUnknownDiscoverableServerCategory:        "Unknown discoverable server category",
+	JSONErrorCodeUnknownSticker:                           "Unknown sticker",
+	JSONErrorCodeUnknownStickerPack:                       "Unknown sticker pack",
+	JSONErrorCodeUnknownInteraction:                       "Unknown interaction",
+	JSONErrorCodeUnknownApplicationCommand:                "Unknown application command",
+	JSONErrorCodeUnknownVoiceState:                        "Unknown voice state",
+	JSONErrorCodeUnknownApplicationCommandPermissions:     "Unknown application command permissions",
+	JSONErrorCodeUnknownStageInstance:                     "Unknown Stage Instance",
+	JSONErrorCodeUnknownGuildMemberVerificationForm:       "Unknown Guild Member Verification Form",
+	JSONErrorCodeUnknownGuildWelcomeScreen:                "Unknown Guild Welcome Screen",
+	JSONErrorCodeUnknownGuildScheduledEvent:               "Unknown Guild Scheduled Event",
+	JSONErrorCodeUnknownGuildScheduledEventUser:           "Unknown Guild Scheduled Event User",
+	JSONErrorCodeUnknownTag:                               "Unknown Tag",
+	JSONErrorCodeUnknownSound:                             "Unknown sound",
+	JSONErrorCodeBotsCannotUseEndpoint:                    "Bots cannot use this endpoint",
+	JSONErrorCodeOnlyBotsCanUseEndpoint:                   "Only bots can use this endpoint",
+	JSONErrorCodeExplicitContentCannotBeSent:              "Explicit content cannot be sent to the desired recipient(s)",
+	JSONErrorCodeNotAuthorizedForApplication:              "You are not authorized to perform this action on this application",
+	JSONErrorCodeSlowmodeRateLimit:                        "This action cannot be performed due to slowmode rate limit",
+	JSONErrorCodeOnlyAccountOwner:                         "Only the owner of this account can perform this action",
+	JSONErrorCodeAnnouncementRateLimit:                    "This message cannot be edited due to announcement rate limits",
+	JSONErrorCodeUnderMinimumAge:                          "Under minimum age",
+	JSONErrorCodeChannelWriteRateLimit:                    "The channel you are writing has hit the write rate limit",
+	JSONErrorCodeServerWriteRateLimit:                     "The write action you are performing on the server has hit the write rate limit",
+	JSONErrorCodeWordsNotAllowed:                          "Your Stage topic, server name, server description, or channel names contain words that are not allowed",
+	JSONErrorCodeGuildPremiumLevelTooLow:                  "Guild premium subscription level too low",
+	JSONErrorCodeMaxGuilds:                                "Maximum number of guilds reached (100)",
+	JSONErrorCodeMaxFriends:                               "Maximum number of friends reached (1000)",
+	JSONErrorCodeMaxPins:                                  "Maximum number of pins reached for the channel (50)",
+	JSONErrorCodeMaxRecipients:                            "Maximum number of recipients reached (10)",
+	JSONErrorCodeMaxGuildRoles:                            "Maximum number of guild roles reached (250)",
+	JSONErrorCodeMaxWebhooks:                              "Maximum number of webhooks reached (15)",
+	JSONErrorCodeMaxEmojis:                                "Maximum number of emojis reached",
+	JSONErrorCodeMaxReactions:                             "Maximum number of reactions reached (20)",
+	JSONErrorCodeMaxGroupDMs:                              "Maximum number of group DMs reached (10)",
+	JSONErrorCodeMaxGuildChannels:                         "Maximum number of guild channels reached (500)",
+	JSONErrorCodeMaxAttachments:                           "Maximum number of attachments in a message reached (10)",
+	JSONErrorCodeMaxInvites:                               "Maximum number of invites reached (1000)",
+	JSONErrorCodeMaxAnimatedEmojis:                        "Maximum number of animated emojis reached",
+	JSONErrorCodeMaxServerMembers:                         "Maximum number of server members reached",
+	JSONErrorCodeMaxServerCategories:                      "Maximum number of server categories has been reached",
+	JSONErrorCodeGuildAlreadyHasTemplate:                  "Guild already has a template",
+	JSONErrorCodeMaxApplicationCommands:                   "Maximum number of application commands reached",
+	JSONErrorCodeMaxThreadParticipants:                    "Maximum number of thread participants has been reached (1000)",
+	JSONErrorCodeMaxDailyApplicationCommandCreates:        "Maximum number of daily application command creates has been reached (200)",
+	JSONErrorCodeMaxNonMemberBans:                         "Maximum number of bans for non-guild members have been exceeded",
+	JSONErrorCodeMaxBanFetches:                            "Maximum number of bans fetches has been reached",
+	JSONErrorCodeMaxUncompletedGuildScheduledEvents:       "Maximum number of uncompleted guild scheduled events reached (100)",
+	JSONErrorCodeMaxStickers:                              "Maximum number of stickers reached",
+	JSONErrorCodeMaxPruneRequests:                         "Maximum number of prune requests has been reached. Try again later",
+	JSONErrorCodeMaxGuildWidgetSettingsUpdates:            "Maximum number of guild widget settings updates has been reached. Try again later",
+	JSONErrorCodeMaxSoundboardSounds:                      "Maximum number of soundboard sounds reached",
+	JSONErrorCodeMaxOldMessageEdits:                       "Maximum number of edits to messages older than 1 hour reached. Try again later",
+	JSONErrorCodeMaxPinnedForumThreads:                    "Maximum number of pinned threads in a forum channel has been reached",
+	JSONErrorCodeMaxForumTags:                             "Maximum number of tags in a forum channel has been reached",
+	JSONErrorCodeBitrateTooHigh:                           "Bitrate is too high for channel of this type",
+	JSONErrorCodeMaxPremiumEmojis:                         "Maximum number of premium emojis reached (25)",
+	JSONErrorCodeMaxGuildWebhooks:                         "Maximum number of webhooks per guild reached (1000)",
+	JSONErrorCodeMaxChannelPermissionOverwrites:           "Maximum number of channel permission overwrites reached (1000)",
+	JSONErrorCodeGuildChannelsTooLarge:                    "The channels for this guild are too large",
+	JSONErrorCodeUnauthorized:                             "Unauthorized. Provide a valid token and try again",
+	JSONErrorCodeAccountVerificationRequired:              "You need to verify your account in order to perform this action",
+	JSONErrorCodeOpeningDMsTooFast:                        "You are opening direct messages too fast",
+	JSONErrorCodeSendMessagesTemporarilyDisabled:          "Send messages has been temporarily disabled",
+	JSONErrorCodeRequestEntityTooLarge:                    "Request entity too large. Try sending something smaller in size",
+	JSONErrorCodeFeatureTemporarilyDisabled:               "This feature has been temporarily disabled server-side",
+	JSONErrorCodeUserBannedFromGuild:                      "The user is banned from this guild",
+	JSONErrorCodeConnectionRevoked:                        "Connection has been revoked",
+	JSONErrorCodeOnlyConsumableSKUs:                       "Only consumable SKUs can be consumed",
+	JSONErrorCodeOnlySandboxEntitlements:                  "You can only delete sandbox entitlements.",
+	JSONErrorCodeTargetUserNotInVoice:                     "Target user is not connected to voice",
+	JSONErrorCodeMessageAlreadyCrossposted:                "This message has already been crossposted",
+	JSONErrorCodeApplicationCommandNameExists:             "An application command with that name already exists",
+	JSONErrorCodeApplicationInteractionFailed:             "Application interaction failed to send",
+	JSONErrorCodeCannotSendInForumChannel:                 "Cannot send a message in a forum channel",
+	JSONErrorCodeInteractionAlreadyAcknowledged:           "Interaction has already been acknowledged",
+	JSONErrorCodeTagNamesMustBeUnique:                     "Tag names must be unique",
+	JSONErrorCodeServiceResourceRateLimited:               "Service resource is being rate limited",
+	JSONErrorCodeNoTagsAvailable:                          "There are no tags available that can be set by non-moderators",
+	JSONErrorCodeTagRequired:                              "A tag is required to create a forum post in this channel",
+	JSONErrorCodeEntitlementAlreadyGranted:                "An entitlement has already been granted for this resource",
+	JSONErrorCodeMaxFollowupMessages:                      "This interaction has hit the maximum number of follow up messages",
+	JSONErrorCodeCloudflareBlocked:                        "Cloudflare is blocking your request. This can often be resolved by setting a proper User Agent",
+	JSONErrorCodeMissingAccess:                            "Missing access",
+	JSONErrorCodeInvalidAccountType:                       "Invalid account type",
+	JSONErrorCodeCannotExecuteOnDMChannel:                 "Cannot execute action on a DM channel",
+	JSONErrorCodeGuildWidgetDisabled:                      "Guild widget disabled",
+	JSONErrorCodeCannotEditOtherUsersMessage:              "Cannot edit a message authored by another user",
+	JSONErrorCodeCannotSendEmptyMessage:                   "Cannot send an empty message",
+	JSONErrorCodeCannotSendMessagesToUser:                 "Cannot send messages to this user",
+	JSONErrorCodeCannotSendMessagesInNonTextChannel:       "Cannot send messages in a non-text channel",
+	JSONErrorCodeChannelVerificationLevelTooHigh:          "Channel verification level is too high for you to gain access",
+	JSONErrorCodeOAuth2ApplicationNoBot:                   "OAuth2 application does not have a bot",
+	JSONErrorCodeOAuth2ApplicationLimitReached:            "OAuth2 application limit reached",
+	JSONErrorCodeInvalidOAuth2State:                       "Invalid OAuth2 state",
+	JSONErrorCodeMissingPermissions:                       "You lack permissions to perform that action",
+	JSONErrorCodeInvalidAuthenticationToken:               "Invalid authentication token provided",
+	JSONErrorCodeNoteTooLong:                              "Note was too long",
+	JSONErrorCodeInvalidBulkDeleteMessageCount:            "Provided too few or too many messages to delete. Must provide at least 2 and fewer than 100 messages to delete",
+	JSONErrorCodeInvalidMFALevel:                          "Invalid MFA Level",
+	JSONErrorCodePinInWrongChannel:                        "A message can only be pinned to the channel it was sent in",
+	JSONErrorCodeInvalidInviteCode:                        "Invite code was either invalid or taken",
+	JSONErrorCodeCannotExecuteOnSystemMessage:             "Cannot execute action on a system message",
+	JSONErrorCodeCannotExecuteOnChannelType:               "Cannot execute action on this channel type",
+	JSONErrorCodeInvalidOAuth2AccessToken:                 "Invalid OAuth2 access token provided",
+	JSONErrorCodeMissingOAuth2Scope:                       "Missing required OAuth2 scope",
+	JSONErrorCodeInvalidWebhookToken:                      "Invalid webhook token provided",
+	JSONErrorCodeInvalidRole:                              "Invalid role",
+	JSONErrorCodeInvalidRecipients:                        "Invalid Recipient(s)",
+	JSONErrorCodeMessageTooOldToBulkDelete:                "A message provided was too old to bulk delete",
+	JSONErrorCodeInvalidFormBody:                          "Invalid form body (returned for both application/json and multipart/form-data bodies), or invalid Content-Type provided",
+	JSONErrorCodeInviteAcceptedToGuildWithoutBot:          "An invite was accepted to a guild the application's bot is not in",
+	JSONErrorCodeInvalidActivityAction:                    "Invalid Activity Action",
+	JSONErrorCodeInvalidAPIVersion:                        "Invalid API version provided",
+	JSONErrorCodeFileTooLarge:                             "File uploaded exceeds the maximum size",
+	JSONErrorCodeInvalidFileUploaded:                      "Invalid file uploaded",
+	JSONErrorCodeCannotSelfRedeemGift:                     "Cannot self-redeem this gift",
+	JSONErrorCodeInvalidGuild:                             "Invalid Guild",
+	JSONErrorCodeInvalidSKU:                               "Invalid SKU",
+	JSONErrorCodeInvalidRequestOrigin:                     "Invalid request origin",
+	JSONErrorCodeInvalidMessageType:                       "Invalid message type",
+	JSONErrorCodePaymentSourceRequired:                    "Payment source required to redeem gift",
+	JSONErrorCodeCannotModifySystemWebhook:                "Cannot modify a system webhook",
+	JSONErrorCodeCannotDeleteCommunityChannel:             "Cannot delete a channel required for Community guilds",
+	JSONErrorCodeCannotEditMessageStickers:                "Cannot edit stickers within a message",
+	JSONErrorCodeInvalidSticker:                           "Invalid sticker sent",
+	JSONErrorCodeThreadArchived:                           "Tried to perform an operation on an archived thread, such as editing a message or adding a user to the thread",
+	JSONErrorCodeInvalidThreadNotificationSettings:        "Invalid thread notification settings",
+	JSONErrorCodeBeforeEarlierThanThreadCreation:          "before value is earlier than the thread creation date",
+	JSONErrorCodeCommunityChannelsMustBeText:              "Community server channels must be text channels",
+	JSONErrorCodeEventEntityTypeMismatch:                  "The entity type of the event is different from the entity you are trying to start the event for",
+	JSONErrorCodeServerNotAvailableInLocation:             "This server is not available in your location",
+	JSONErrorCodeMonetizationRequired:                     "This server needs monetization enabled in order to perform this action",
+	JSONErrorCodeMoreBoostsRequired:                       "This server needs more boosts to perform this action",
+	JSONErrorCodeInvalidJSON:                              "The request body contains invalid JSON.",
+	JSONErrorCodeInvalidFile:                              "The provided file is invalid.",
+	JSONErrorCodeInvalidFileType:                          "The provided file type is invalid.",
+	JSONErrorCodeFileDurationTooLong:                      "The provided file duration exceeds maximum of 5.2 seconds.",
+	JSONErrorCodeOwnerCannotBePending:                     "Owner cannot be pending member",
+	JSONErrorCodeCannotTransferOwnershipToBot:             "Ownership cannot be transferred to a bot user",
+	JSONErrorCodeFailedToResizeAsset:                      "Failed to resize asset below the maximum size: 262144",
+	JSONErrorCodeCannotMixSubscriptionRoles:               "Cannot mix subscription and non subscription roles for an emoji",
+	JSONErrorCodeCannotConvertPremiumEmoji:                "Cannot convert between premium emoji and normal emoji",
+	JSONErrorCodeUploadedFileNotFound:                     "Uploaded file not found.",
+	JSONErrorCodeInvalidEmoji:                             "The specified emoji is invalid",
+	JSONErrorCodeVoiceMessagesNoAdditionalContent:         "Voice messages do not support additional content.",
+	JSONErrorCodeVoiceMessagesSingleAudioAttachment:       "Voice messages must have a single audio attachment.",
+	JSONErrorCodeVoiceMessagesMetadataRequired:            "Voice messages must have supporting metadata.",
+	JSONErrorCodeVoiceMessagesCannotBeEdited:              "Voice messages cannot be edited.",
+	JSONErrorCodeCannotDeleteGuildSubscriptionIntegration: "Cannot delete guild subscription integration",
+	JSONErrorCodeCannotSendVoiceMessagesInChannel:         "You cannot send voice messages in this channel.",
+	JSONErrorCodeUserAccountMustBeVerified:                "The user account must first be verified",
+	JSONErrorCodeInvalidFileDuration:                      "The provided file does not have a valid duration.",
+	JSONErrorCodeNoPermissionToSendSticker:                "You do not have permission to send this sticker.",
+	JSONErrorCodeTwoFactorRequired:                        "Two factor is required for this operation",
+	JSONErrorCodeNoUsersWithDiscordTag:                    "No users with DiscordTag exist",
+	JSONErrorCodeReactionBlocked:                          "Reaction was blocked",
+	JSONErrorCodeCannotUseBurstReactions:                  "User cannot use burst reactions",
+	JSONErrorCodeApplicationNotYetAvailable:               "Application not yet available. Try again later",
+	JSONErrorCodeAPIResourceOverloaded:                    "API resource is currently overloaded. Try again a little later",
+	JSONErrorCodeStageAlreadyOpen:                         "The Stage is already open",
+	JSONErrorCodeCannotReplyWithoutReadMessageHistory:     "Cannot reply without permission to read message history",
+	JSONErrorCodeThreadAlreadyCreatedForMessage:           "A thread has already been created for this message",
+	JSONErrorCodeThreadLocked:                             "Thread is locked",
+	JSONErrorCodeMaxActiveThreads:                         "Maximum number of active threads reached",
+	JSONErrorCodeMaxActiveAnnouncementThreads:             "Maximum number of active announcement threads reached",
+	JSONErrorCodeInvalidLottieJSON:                        "Invalid JSON for uploaded Lottie file",
+	JSONErrorCodeLottieRasterizedImages:                   "Uploaded Lotties cannot contain rasterized images such as PNG or JPEG",
+	JSONErrorCodeStickerMaxFramerateExceeded:              "Sticker maximum framerate exceeded",
+	JSONErrorCodeStickerMaxFrameCountExceeded:             "Sticker frame count exceeds maximum of 1000 frames",
+	JSONErrorCodeLottieMaxDimensionsExceeded:              "Lottie animation maximum dimensions exceeded",
+	JSONErrorCodeStickerInvalidFrameRate:                  "Sticker frame rate is either too small or too large",
+	JSONErrorCodeStickerAnimationDurationTooLong:          "Sticker animation duration exceeds maximum of 5 seconds",
+	JSONErrorCodeCannotUpdateFinishedEvent:                "Cannot update a finished event",
+	JSONErrorCodeFailedToCreateStageForEvent:              "Failed to create stage needed for stage event",
+	JSONErrorCodeMessageBlockedByAutoModeration:           "Message was blocked by automatic moderation",
+	JSONErrorCodeTitleBlockedByAutoModeration:             "Title was blocked by automatic moderation",
+	JSONErrorCodeForumWebhookThreadRequired:               "Webhooks posted to forum channels must have a thread_name or thread_id",
+	JSONErrorCodeForumWebhookThreadNameAndID:              "Webhooks posted to forum channels cannot have both a thread_name and thread_id",
+	JSONErrorCodeWebhookThreadsOnlyInForum:                "Webhooks can only create threads in forum channels",
+	JSONErrorCodeWebhookServicesNotInForum:                "Webhook services cannot be used in forum channels",
+	JSONErrorCodeMessageBlockedByHarmfulLinksFilter:       "Message blocked by harmful links filter",
+	JSONErrorCodeCannotEnableOnboarding:                   "Cannot enable onboarding, requirements are not met",
+	JSONErrorCodeCannotUpdateOnboarding:                   "Cannot update onboarding while below requirements",
+	JSONErrorCodeFailedToBanUsers:                         "Failed to ban users",
+	JSONErrorCodePollVotingBlocked:                        "Poll voting blocked",
+	JSONErrorCodePollExpired:                              "Poll expired",
+	JSONErrorCodeInvalidPollChannelType:                   "Invalid channel type for poll creation",
+	JSONErrorCodeCannotEditPollMessage:                    "Cannot edit a poll message",
+	JSONErrorCodeCannotUsePollEmoji:                       "Cannot use an emoji included with the poll",
+	JSONErrorCodeCannotExpireNonPollMessage:               "Cannot expire a non-poll message",
+}
@@ -0,0 +1,56 @@
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestError_Is(t *testing.T) {
+	t.Parallel()
+
+	err := NewError(nil, nil, &http.Response{StatusCode: http.StatusNotFound}, []byte(`{"code": 10008, "message": "Unknown Message"}`))
+	wrapped := fmt.Errorf("failed to get message: %w", err)
+
+	assert.ErrorIs(t, wrapped, JSONErrorCodeUnknownMessage)
+	assert.False(t, errors.Is(wrapped, JSONErrorCodeMissingAccess))
+	assert.False(t, errors.Is(wrapped, JSONErrorCodeGeneral))
+
+	err = NewError(nil, nil, &http.Response{StatusCode: http.StatusBadRequest}, []byte(`{"code": 0, "message": "400: Bad Request"}`))
+	assert.ErrorIs(t, err, JSONErrorCodeGeneral)
+	assert.Equal(t, "0: 400: Bad Request", err.Error())
+
+	// responses without a JSON body have no JSONErrorCode
+	err = NewError(nil, nil, &http.Response{StatusCode: http.StatusBadGateway, Status: "502 Bad Gateway"}, []byte(`<html></html>`))
+	assert.False(t, errors.Is(err, JSONErrorCodeGeneral))
+	assert.Equal(t, "Status: 502 Bad Gateway, Body: <html></html>", err.Error())
+}
+
+func TestError_FieldErrors(t *testing.T) {
+	t.Parallel()
+
+	err := NewError(nil, nil, &http.Response{StatusCode: http.StatusBadRequest}, []byte(`{
+		"code": 50035,
+		"message": "Invalid Form Body",
+		"errors": {
+			"embeds": {"0": {"fields": {"2": {"value": {"_errors": [{"code": "BASE_TYPE_MAX_LENGTH", "message": "Must be 1024 or fewer in length."}]}}}}},
+			"content": {"_errors": [{"code": "BASE_TYPE_REQUIRED", "message": "This field is required"}]}
+		}
+	}`))
+
+	var restErr Error
+	assert.True(t, errors.As(err, &restErr))
+	assert.ErrorIs(t, err, JSONErrorCodeInvalidFormBody)
+
+	fieldErrors := restErr.FieldErrors()
+	assert.NotNil(t, fieldErrors)
+	assert.Equal(t, []FieldError{
+		{Path: "content", Code: "BASE_TYPE_REQUIRED", Message: "This field is required"},
+		{Path: "embeds.0.fields.2.value", Code: "BASE_TYPE_MAX_LENGTH", Message: "Must be 1024 or fewer in length."},
+	}, fieldErrors.All())
+	assert.Equal(t, "BASE_TYPE_MAX_LENGTH", fieldErrors.Get("embeds.0.fields.2.value").Errors[0].Code)
+	assert.Nil(t, fieldErrors.Get("embeds.1"))
+}
@@ -0,0 +1,50 @@
+package rest
+
+import (
+	"errors"
+	"net/http"
+)
+
+// ErrNoResponse is returned when the Middleware chain neither set a RoundTrip.Response nor returned an error.
+var ErrNoResponse = errors.New("middleware returned no response")
+
+// RoundTrip is a single attempt of a request to a CompiledEndpoint which is passed through the Middleware chain.
+type RoundTrip struct {
+	// Endpoint is the CompiledEndpoint the request is made to
+	Endpoint *CompiledEndpoint
+	// Bucket is the key the RateLimiter groups the request by
+	Bucket string
+	// Attempt is the number of this attempt starting at 1. It increases with each rate limit or transient error retry
+	Attempt int
+
+	// Request is the http.Request which is about to be sent
+	Request *http.Request
+	// RqBody is the raw request body
+	RqBody []byte
+
+	// Response is the http.Response. It is only set after the next RoundTripFunc returned
+	Response *http.Response
+	// RsBody is the raw response body. It is only set after the next RoundTripFunc returned
+	RsBody []byte
+}
+
+type (
+	// RoundTripFunc sends the RoundTrip and returns the decoded error. For non 2xx responses this is an Error.
+	RoundTripFunc func(rt *RoundTrip) error
+
+	// Middleware is a function that wraps a RoundTripFunc to intercept, modify or short-circuit requests and responses.
+	// A Middleware which short-circuits the chain must either set RoundTrip.Response & RoundTrip.RsBody itself or return an error.
+	// Errors returned by a Middleware without a response are not retried.
+	Middleware func(next RoundTripFunc) RoundTripFunc
+
+	// Middlewares is a list of middlewares.
+	Middlewares []Middleware
+)
+
+// Then wraps the given RoundTripFunc with the Middlewares. The first Middleware is the outermost one.
+func (m Middlewares) Then(roundTrip RoundTripFunc) RoundTripFunc {
+	for i := len(m) - 1; i >= 0; i-- {
+		roundTrip = m[i](roundTrip)
+	}
+	return roundTrip
+}
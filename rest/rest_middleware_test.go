@@ -0,0 +1,134 @@
+package rest
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/disgoorg/disgo/discord"
+)
+
+func recordMiddleware(calls *[]string, name string) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(rt *RoundTrip) error {
+			*calls = append(*calls, name+" before")
+			err := next(rt)
+			*calls = append(*calls, name+" after")
+			return err
+		}
+	}
+}
+
+func shortCircuitMiddleware(statusCode int, body string) Middleware {
+	return func(_ RoundTripFunc) RoundTripFunc {
+		return func(rt *RoundTrip) error {
+			rt.Response = &http.Response{StatusCode: statusCode, Status: http.StatusText(statusCode), Header: http.Header{}, Body: http.NoBody}
+			rt.RsBody = []byte(body)
+			return nil
+		}
+	}
+}
+
+func newMiddlewareTestClient(t *testing.T, opts ...ConfigOpt) (Client, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+
+	return NewClient("", append([]ConfigOpt{WithURL(srv.URL), WithRateLimiter(NewNoopRateLimiter())}, opts...)...), &calls
+}
+
+func TestMiddlewares_Then(t *testing.T) {
+	t.Parallel()
+
+	var calls []string
+	roundTrip := Middlewares{recordMiddleware(&calls, "a"), recordMiddleware(&calls, "b")}.Then(func(_ *RoundTrip) error {
+		calls = append(calls, "round trip")
+		return nil
+	})
+	require.NoError(t, roundTrip(&RoundTrip{}))
+	assert.Equal(t, []string{"a before", "b before", "round trip", "b after", "a after"}, calls)
+}
+
+func TestClient_Middlewares(t *testing.T) {
+	t.Parallel()
+
+	var calls []string
+	client, requests := newMiddlewareTestClient(t, WithMiddlewares(recordMiddleware(&calls, "client")))
+	err := client.Do(DeleteChannel.Compile(nil, 1), nil, nil, WithRequestMiddlewares(recordMiddleware(&calls, "request")))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"client before", "request before", "request after", "client after"}, calls)
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+func TestClient_MiddlewareShortCircuit(t *testing.T) {
+	t.Parallel()
+
+	client, requests := newMiddlewareTestClient(t, WithMiddlewares(shortCircuitMiddleware(http.StatusOK, `{"id":"5","type":0}`)))
+	var channel discord.UnmarshalChannel
+	err := client.Do(GetChannel.Compile(nil, 1), nil, &channel)
+	require.NoError(t, err)
+
+	assert.Equal(t, snowflake.ID(5), channel.ID())
+	assert.Zero(t, requests.Load())
+}
+
+func TestClient_MiddlewareShortCircuitError(t *testing.T) {
+	t.Parallel()
+
+	client, requests := newMiddlewareTestClient(t)
+	err := client.Do(CreateMessage.Compile(nil, 1), nil, nil,
+		WithRequestMiddlewares(shortCircuitMiddleware(http.StatusForbidden, `{"code":50013,"message":"Missing Permissions"}`)),
+	)
+
+	var rsErr Error
+	require.ErrorAs(t, err, &rsErr)
+	assert.Equal(t, http.StatusForbidden, rsErr.Response.StatusCode)
+	assert.Equal(t, JSONErrorCode(50013), rsErr.Code)
+	assert.Zero(t, requests.Load())
+}
+
+func TestClient_MiddlewareNoResponse(t *testing.T) {
+	t.Parallel()
+
+	client, requests := newMiddlewareTestClient(t)
+	err := client.Do(DeleteChannel.Compile(nil, 1), nil, nil, WithRequestMiddlewares(func(_ RoundTripFunc) RoundTripFunc {
+		return func(_ *RoundTrip) error {
+			return nil
+		}
+	}))
+	require.ErrorIs(t, err, ErrNoResponse)
+	assert.Zero(t, requests.Load())
+}
+
+func TestClient_MiddlewareErrorNotRetried(t *testing.T) {
+	t.Parallel()
+
+	policy := DefaultRetryPolicy()
+	policy.BaseDelay = time.Millisecond
+	client, requests := newMiddlewareTestClient(t, WithRetryPolicy(policy))
+
+	errRejected := errors.New("rejected")
+	var calls atomic.Int32
+	err := client.Do(GetChannel.Compile(nil, 1), nil, nil, WithRequestMiddlewares(func(_ RoundTripFunc) RoundTripFunc {
+		return func(_ *RoundTrip) error {
+			calls.Add(1)
+			return errRejected
+		}
+	}))
+	require.ErrorIs(t, err, errRejected)
+	assert.Equal(t, int32(1), calls.Load())
+	assert.Zero(t, requests.Load())
+}
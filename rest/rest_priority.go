@@ -0,0 +1,139 @@
+package rest
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Priority is the priority of a request in the RateLimiter.
+// Requests waiting for the same bucket or the global rate limit are sent by priority first and then in the order they were queued.
+type Priority int
+
+// All Priority(s) with PriorityNormal being the default
+const (
+	PriorityLow Priority = iota - 1
+	PriorityNormal
+	PriorityHigh
+	PriorityCritical
+)
+
+type priorityCtxKey struct{}
+
+// ContextWithPriority returns a copy of the context.Context which carries the given Priority to the RateLimiter
+func ContextWithPriority(ctx context.Context, priority Priority) context.Context {
+	return context.WithValue(ctx, priorityCtxKey{}, priority)
+}
+
+// PriorityFromContext returns the Priority of the context.Context or PriorityNormal if none is set
+func PriorityFromContext(ctx context.Context) Priority {
+	if priority, ok := ctx.Value(priorityCtxKey{}).(Priority); ok {
+		return priority
+	}
+	return PriorityNormal
+}
+
+// newPriorityMutex returns a new priorityMutex. Every agingInterval a waiter waits, its priority is raised by one to prevent starvation.
+func newPriorityMutex(agingInterval time.Duration) *priorityMutex {
+	return &priorityMutex{agingInterval: agingInterval}
+}
+
+// priorityMutex is a mutex which hands the lock to its waiters ordered by their aged Priority and then FIFO
+type priorityMutex struct {
+	agingInterval time.Duration
+
+	mu      sync.Mutex
+	locked  bool
+	waiters []*priorityWaiter
+}
+
+type priorityWaiter struct {
+	priority Priority
+	queued   time.Time
+	ready    chan struct{}
+}
+
+// effectivePriority returns the priority of the waiter raised by one for every agingInterval it waited
+func (w *priorityWaiter) effectivePriority(now time.Time, agingInterval time.Duration) Priority {
+	if agingInterval <= 0 {
+		return w.priority
+	}
+	return w.priority + Priority(now.Sub(w.queued)/agingInterval)
+}
+
+// Lock waits until the lock is handed to the caller or the context.Context is done
+func (m *priorityMutex) Lock(ctx context.Context, priority Priority) error {
+	m.mu.Lock()
+	if !m.locked && len(m.waiters) == 0 {
+		m.locked = true
+		m.mu.Unlock()
+		return nil
+	}
+	w := &priorityWaiter{
+		priority: priority,
+		queued:   time.Now(),
+		ready:    make(chan struct{}),
+	}
+	m.waiters = append(m.waiters, w)
+	m.mu.Unlock()
+
+	select {
+	case <-w.ready:
+		return nil
+	case <-ctx.Done():
+		m.mu.Lock()
+		for i, waiter := range m.waiters {
+			if waiter == w {
+				m.waiters = append(m.waiters[:i], m.waiters[i+1:]...)
+				m.mu.Unlock()
+				return ctx.Err()
+			}
+		}
+		m.mu.Unlock()
+		// the lock has been handed to us in the meantime, so pass it on
+		m.Unlock()
+		return ctx.Err()
+	}
+}
+
+// TryLock locks the mutex if it is neither locked nor has waiters
+func (m *priorityMutex) TryLock() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.locked || len(m.waiters) > 0 {
+		return false
+	}
+	m.locked = true
+	return true
+}
+
+// Unlock hands the lock to the waiter with the highest aged Priority or unlocks the mutex if there are no waiters
+func (m *priorityMutex) Unlock() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(m.waiters) == 0 {
+		m.locked = false
+		return
+	}
+
+	now := time.Now()
+	next := 0
+	nextPriority := m.waiters[0].effectivePriority(now, m.agingInterval)
+	for i, w := range m.waiters[1:] {
+		// waiters are appended in FIFO order, so only a strictly higher priority wins
+		if priority := w.effectivePriority(now, m.agingInterval); priority > nextPriority {
+			next = i + 1
+			nextPriority = priority
+		}
+	}
+	w := m.waiters[next]
+	m.waiters = append(m.waiters[:next], m.waiters[next+1:]...)
+	close(w.ready)
+}
+
+// Waiters returns the number of waiters
+func (m *priorityMutex) Waiters() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.waiters)
+}
@@ -5,11 +5,11 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"slices"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
-
-	"github.com/sasha-s/go-csync"
 )
 
 const (
@@ -17,6 +17,8 @@ const (
 	MaxRetries = 10
 	// CleanupInterval is the interval at which the rate limiter cleans up old buckets
 	CleanupInterval = time.Second * 10
+	// PriorityAgingInterval is the default interval after which the Priority of a waiting request is raised by one
+	PriorityAgingInterval = time.Second * 5
 )
 
 // RateLimiter can be used to supply your own rate limit implementation
@@ -31,22 +33,95 @@ type RateLimiter interface {
 	// Reset resets the rate limiter to its initial state
 	Reset()
 
-	// WaitBucket waits for the given bucket to be available for new requests & locks it
+	// WaitBucket waits for the given bucket to be available for new requests & locks it.
+	// The Priority of the request can be retrieved with PriorityFromContext.
 	WaitBucket(ctx context.Context, endpoint *CompiledEndpoint) error
 
 	// UnlockBucket unlocks the given bucket and calculates the rate limit for the next request
 	UnlockBucket(endpoint *CompiledEndpoint, rs *http.Response) error
 }
 
+// RateLimiterInspector is implemented by RateLimiter(s) which expose their buckets & the global rate limit.
+// The default RateLimiter implements it.
+type RateLimiterInspector interface {
+	// Buckets returns a snapshot of all currently known buckets
+	Buckets() []BucketInfo
+
+	// Global returns a snapshot of the global rate limit
+	Global() GlobalRateLimitInfo
+
+	// EstimateWait estimates how long a new request to the given endpoint would wait for rate limits before being sent.
+	// It takes the requests already queued for the bucket and the global rate limit into account, but not the latency of the requests.
+	EstimateWait(endpoint *CompiledEndpoint) time.Duration
+}
+
+// RateLimiterStatsProvider is implemented by RateLimiter(s) which can report their RateLimiterStats.
+// The default RateLimiter implements it.
+type RateLimiterStatsProvider interface {
+	// Stats returns a snapshot of the current RateLimiterStats
+	Stats() RateLimiterStats
+}
+
+// BucketInfo is a read-only snapshot of a rate limit bucket.
+type BucketInfo struct {
+	// Key is the route hash plus major parameters which identifies the bucket in the RateLimiter
+	Key string `json:"key"`
+	// ID is the bucket hash sent by Discord in the X-RateLimit-Bucket header. It is empty until the first response
+	ID string `json:"id"`
+	// Limit is the number of requests which can be made per reset window. It is -1 until the first response
+	Limit int `json:"limit"`
+	// Remaining is the number of requests which can be made until the bucket resets
+	Remaining int `json:"remaining"`
+	// Reset is the time the bucket resets
+	Reset time.Time `json:"reset"`
+	// InFlight is true if a request of the bucket is currently in flight or waiting for the reset
+	InFlight bool `json:"in_flight"`
+	// Waiters is the number of requests queued for the bucket
+	Waiters int `json:"waiters"`
+}
+
+// GlobalRateLimitInfo is a read-only snapshot of the global rate limit.
+type GlobalRateLimitInfo struct {
+	// Limited is true if the global rate limit is currently hit
+	Limited bool `json:"limited"`
+	// Reset is the time the global rate limit resets. It is zero if the global rate limit is not hit
+	Reset time.Time `json:"reset"`
+}
+
+// RateLimiterStats is a snapshot of the state of a RateLimiter.
+type RateLimiterStats struct {
+	// Buckets is the number of currently known buckets
+	Buckets int `json:"buckets"`
+	// LockedBuckets is the number of buckets which currently have a request in flight or waiting for their reset
+	LockedBuckets int `json:"locked_buckets"`
+	// ExhaustedBuckets is the number of unlocked buckets which have no remaining requests until they reset
+	ExhaustedBuckets int `json:"exhausted_buckets"`
+	// GlobalReset is the time the global rate limit resets. It is zero if the global rate limit is not hit
+	GlobalReset time.Time `json:"global_reset"`
+}
+
+// Saturation returns the ratio of exhausted buckets to all known buckets in the range [0, 1].
+// It returns 1 if the global rate limit is currently hit.
+func (s RateLimiterStats) Saturation() float64 {
+	if s.GlobalReset.After(time.Now()) {
+		return 1
+	}
+	if s.Buckets == 0 {
+		return 0
+	}
+	return float64(s.ExhaustedBuckets) / float64(s.Buckets)
+}
+
 // NewRateLimiter return a new default RateLimiter with the given RateLimiterConfigOpt(s).
 func NewRateLimiter(opts ...RateLimiterConfigOpt) RateLimiter {
 	cfg := defaultRateLimiterConfig()
 	cfg.apply(opts)
 
 	rateLimiter := &rateLimiterImpl{
-		config:  cfg,
-		hashes:  map[*Endpoint]string{},
-		buckets: map[string]*bucket{},
+		config:     cfg,
+		globalLock: newPriorityMutex(cfg.AgingInterval),
+		hashes:     map[*Endpoint]string{},
+		buckets:    map[string]*bucket{},
 	}
 
 	go rateLimiter.cleanup()
@@ -54,11 +129,19 @@ func NewRateLimiter(opts ...RateLimiterConfigOpt) RateLimiter {
 	return rateLimiter
 }
 
+var (
+	_ RateLimiterStatsProvider = (*rateLimiterImpl)(nil)
+	_ RateLimiterInspector     = (*rateLimiterImpl)(nil)
+)
+
 type rateLimiterImpl struct {
 	config rateLimiterConfig
 
 	// global Rate Limit
-	global time.Time
+	global   time.Time
+	globalMu sync.Mutex
+	// globalLock orders requests waiting for the global rate limit by Priority
+	globalLock *priorityMutex
 
 	// APIRoute -> Hash
 	hashes   map[*Endpoint]string
@@ -106,7 +189,7 @@ func (l *rateLimiterImpl) Close(ctx context.Context) {
 		wg.Add(1)
 		b := l.buckets[i]
 		go func() {
-			_ = b.mu.CLock(ctx)
+			_ = b.mu.Lock(ctx, PriorityCritical)
 			wg.Done()
 		}()
 	}
@@ -119,11 +202,117 @@ func (l *rateLimiterImpl) Reset() {
 	defer l.bucketsMu.Unlock()
 	defer l.hashesMu.Unlock()
 
-	l.global = time.Time{}
+	l.setGlobal(time.Time{})
 	clear(l.buckets)
 	clear(l.hashes)
 }
 
+func (l *rateLimiterImpl) Stats() RateLimiterStats {
+	l.bucketsMu.Lock()
+	defer l.bucketsMu.Unlock()
+
+	stats := RateLimiterStats{
+		Buckets: len(l.buckets),
+	}
+	now := time.Now()
+	if global := l.getGlobal(); global.After(now) {
+		stats.GlobalReset = global
+	}
+	for _, b := range l.buckets {
+		info := b.info("")
+		if info.InFlight {
+			stats.LockedBuckets++
+			continue
+		}
+		if info.Remaining == 0 && info.Reset.After(now) {
+			stats.ExhaustedBuckets++
+		}
+	}
+	return stats
+}
+
+func (l *rateLimiterImpl) Buckets() []BucketInfo {
+	l.bucketsMu.Lock()
+	defer l.bucketsMu.Unlock()
+
+	buckets := make([]BucketInfo, 0, len(l.buckets))
+	for key, b := range l.buckets {
+		buckets = append(buckets, b.info(key))
+	}
+	slices.SortFunc(buckets, func(a BucketInfo, b BucketInfo) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+	return buckets
+}
+
+func (l *rateLimiterImpl) Global() GlobalRateLimitInfo {
+	global := l.getGlobal()
+	if !global.After(time.Now()) {
+		return GlobalRateLimitInfo{}
+	}
+	return GlobalRateLimitInfo{
+		Limited: true,
+		Reset:   global,
+	}
+}
+
+func (l *rateLimiterImpl) EstimateWait(endpoint *CompiledEndpoint) time.Duration {
+	now := time.Now()
+	var wait time.Duration
+	if global := l.getGlobal(); global.After(now) {
+		wait = global.Sub(now)
+	}
+
+	b := l.getBucket(endpoint, false)
+	if b == nil {
+		return wait
+	}
+	info := b.info("")
+
+	// requests which have to be sent before ours, including ours
+	ahead := info.Waiters + 1
+	if info.InFlight {
+		ahead++
+	}
+
+	remaining := info.Remaining
+	resetIn := info.Reset.Sub(now)
+	window := b.window()
+	if resetIn <= 0 {
+		// the bucket already reset, so the next window starts with the next request
+		if info.Limit > 0 {
+			remaining = info.Limit
+		}
+		resetIn = window
+	}
+	if ahead <= remaining {
+		return wait
+	}
+	if info.Limit <= 0 {
+		// we don't know the limit yet, so we have to wait at least until the bucket resets
+		return max(wait, resetIn)
+	}
+
+	if window <= 0 {
+		window = resetIn
+	}
+	// full windows needed after the next reset
+	windows := (ahead - remaining - 1) / info.Limit
+	return max(wait, resetIn+time.Duration(windows)*window)
+}
+
+func (l *rateLimiterImpl) getGlobal() time.Time {
+	l.globalMu.Lock()
+	defer l.globalMu.Unlock()
+	return l.global
+}
+
+func (l *rateLimiterImpl) setGlobal(global time.Time) {
+	l.globalMu.Lock()
+	defer l.globalMu.Unlock()
+	l.global = global
+}
+
 func (l *rateLimiterImpl) getRouteHash(endpoint *CompiledEndpoint) string {
 	l.hashesMu.Lock()
 	hash, ok := l.hashes[endpoint.Endpoint]
@@ -155,6 +344,7 @@ func (l *rateLimiterImpl) getBucket(endpoint *CompiledEndpoint, create bool) *bu
 		}
 
 		b = &bucket{
+			mu:        newPriorityMutex(l.config.AgingInterval),
 			Remaining: 1,
 			// we don't know the limit yet
 			Limit: -1,
@@ -166,37 +356,71 @@ func (l *rateLimiterImpl) getBucket(endpoint *CompiledEndpoint, create bool) *bu
 
 func (l *rateLimiterImpl) WaitBucket(ctx context.Context, endpoint *CompiledEndpoint) error {
 	b := l.getBucket(endpoint, true)
-	l.config.Logger.Debug("locking rest bucket", slog.String("id", b.ID), slog.Int("limit", b.Limit), slog.Int("remaining", b.Remaining), slog.Time("reset", b.Reset))
-	if err := b.mu.CLock(ctx); err != nil {
+	priority := PriorityFromContext(ctx)
+	l.config.Logger.Debug("locking rest bucket", slog.String("id", b.ID), slog.Int("limit", b.Limit), slog.Int("remaining", b.Remaining), slog.Time("reset", b.Reset), slog.Int("priority", int(priority)))
+	if err := b.mu.Lock(ctx, priority); err != nil {
 		return err
 	}
+	b.setInFlight(true)
 
-	var until time.Time
 	now := time.Now()
-
 	if b.Remaining == 0 && b.Reset.After(now) {
-		until = b.Reset
-	} else {
-		until = l.global
-	}
-
-	if until.After(now) {
-		// TODO: do we want to return early when we know the rate limit bigger than ctx deadline?
-		if deadline, ok := ctx.Deadline(); ok && until.After(deadline) {
+		if err := sleepUntil(ctx, b.Reset); err != nil {
+			b.setInFlight(false)
 			b.mu.Unlock()
-			return context.DeadlineExceeded
+			return err
 		}
+	}
 
-		select {
-		case <-ctx.Done():
-			b.mu.Unlock()
-			return ctx.Err()
-		case <-time.After(until.Sub(now)):
-		}
+	if err := l.waitGlobal(ctx, priority); err != nil {
+		b.setInFlight(false)
+		b.mu.Unlock()
+		return err
 	}
 	return nil
 }
 
+// waitGlobal waits for the global rate limit to reset.
+// Requests waiting for the global rate limit pass one after another ordered by Priority.
+func (l *rateLimiterImpl) waitGlobal(ctx context.Context, priority Priority) error {
+	if !l.getGlobal().After(time.Now()) && l.globalLock.TryLock() {
+		l.globalLock.Unlock()
+		return nil
+	}
+
+	if err := l.globalLock.Lock(ctx, priority); err != nil {
+		return err
+	}
+	defer l.globalLock.Unlock()
+	// the global rate limit could have been hit again while we were waiting
+	for {
+		global := l.getGlobal()
+		if !global.After(time.Now()) {
+			return nil
+		}
+		if err := sleepUntil(ctx, global); err != nil {
+			return err
+		}
+	}
+}
+
+// sleepUntil sleeps until the given time or returns early if the context.Context is done or its deadline is before the given time
+func sleepUntil(ctx context.Context, until time.Time) error {
+	// TODO: do we want to return early when we know the rate limit bigger than ctx deadline?
+	if deadline, ok := ctx.Deadline(); ok && until.After(deadline) {
+		return context.DeadlineExceeded
+	}
+
+	timer := time.NewTimer(time.Until(until))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
 func (l *rateLimiterImpl) UnlockBucket(endpoint *CompiledEndpoint, rs *http.Response) error {
 	b := l.getBucket(endpoint, false)
 	if b == nil {
@@ -204,6 +428,7 @@ func (l *rateLimiterImpl) UnlockBucket(endpoint *CompiledEndpoint, rs *http.Resp
 	}
 	defer func() {
 		l.config.Logger.Debug("unlocking rest bucket", slog.String("id", b.ID), slog.Int("limit", b.Limit), slog.Int("remaining", b.Remaining), slog.Time("reset", b.Reset))
+		b.setInFlight(false)
 		b.mu.Unlock()
 	}()
 
@@ -218,6 +443,9 @@ func (l *rateLimiterImpl) UnlockBucket(endpoint *CompiledEndpoint, rs *http.Resp
 		return nil
 	}
 
+	b.stateMu.Lock()
+	defer b.stateMu.Unlock()
+
 	b.ID = bucketHeader
 
 	global := rs.Header.Get("X-RateLimit-Global") != ""
@@ -238,10 +466,10 @@ func (l *rateLimiterImpl) UnlockBucket(endpoint *CompiledEndpoint, rs *http.Resp
 		}
 		reset := time.Now().Add(time.Second * time.Duration(retryAfter))
 		if global {
-			l.global = reset
+			l.setGlobal(reset)
 			l.config.Logger.Warn("global rate limit exceeded", slog.Int("retry_after", retryAfter))
 		} else if cloudflare {
-			l.global = reset
+			l.setGlobal(reset)
 			l.config.Logger.Warn("cloudflare rate limit exceeded", slog.Int("retry_after", retryAfter))
 		} else {
 			b.Remaining = 0
@@ -274,6 +502,7 @@ func (l *rateLimiterImpl) UnlockBucket(endpoint *CompiledEndpoint, rs *http.Resp
 			return fmt.Errorf("invalid reset after %s: %w", resetAfterHeader, err)
 		}
 
+		b.Window = time.Duration(resetAfter * float64(time.Second))
 		b.Reset = time.Now().Add(time.Duration(resetAfter) * time.Second)
 	} else if resetHeader != "" {
 		reset, err := strconv.ParseFloat(resetHeader, 64)
@@ -290,9 +519,41 @@ func (l *rateLimiterImpl) UnlockBucket(endpoint *CompiledEndpoint, rs *http.Resp
 }
 
 type bucket struct {
-	mu        csync.Mutex
+	mu *priorityMutex
+
+	// stateMu guards the fields below for introspection, they are only written while holding mu
+	stateMu   sync.Mutex
+	inFlight  bool
 	ID        string
 	Reset     time.Time
 	Remaining int
 	Limit     int
+	// Window is the duration of the last reset window reported by Discord
+	Window time.Duration
+}
+
+func (b *bucket) setInFlight(inFlight bool) {
+	b.stateMu.Lock()
+	defer b.stateMu.Unlock()
+	b.inFlight = inFlight
+}
+
+func (b *bucket) window() time.Duration {
+	b.stateMu.Lock()
+	defer b.stateMu.Unlock()
+	return b.Window
+}
+
+func (b *bucket) info(key string) BucketInfo {
+	b.stateMu.Lock()
+	defer b.stateMu.Unlock()
+	return BucketInfo{
+		Key:       key,
+		ID:        b.ID,
+		Limit:     b.Limit,
+		Remaining: b.Remaining,
+		Reset:     b.Reset,
+		InFlight:  b.inFlight,
+		Waiters:   b.mu.Waiters(),
+	}
 }
@@ -10,6 +10,7 @@ func defaultRateLimiterConfig() rateLimiterConfig {
 		Logger:          slog.Default(),
 		MaxRetries:      MaxRetries,
 		CleanupInterval: CleanupInterval,
+		AgingInterval:   PriorityAgingInterval,
 	}
 }
 
@@ -17,6 +18,7 @@ type rateLimiterConfig struct {
 	Logger          *slog.Logger
 	MaxRetries      int
 	CleanupInterval time.Duration
+	AgingInterval   time.Duration
 }
 
 // RateLimiterConfigOpt can be used to supply optional parameters to NewRateLimiter.
@@ -49,3 +51,11 @@ func WithCleanupInterval(cleanupInterval time.Duration) RateLimiterConfigOpt {
 		config.CleanupInterval = cleanupInterval
 	}
 }
+
+// WithPriorityAgingInterval sets how long a request has to wait until its Priority is raised by one.
+// This prevents low priority requests from waiting forever behind a constant flow of higher priority ones. 0 disables aging.
+func WithPriorityAgingInterval(agingInterval time.Duration) RateLimiterConfigOpt {
+	return func(config *rateLimiterConfig) {
+		config.AgingInterval = agingInterval
+	}
+}
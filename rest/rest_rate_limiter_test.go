@@ -0,0 +1,117 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func rateLimitResponse(limit string, remaining string, resetAfter string) *http.Response {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header: http.Header{
+			"X-Ratelimit-Bucket":      []string{"abc"},
+			"X-Ratelimit-Limit":       []string{limit},
+			"X-Ratelimit-Remaining":   []string{remaining},
+			"X-Ratelimit-Reset-After": []string{resetAfter},
+		},
+	}
+}
+
+func TestRateLimiter_Introspection(t *testing.T) {
+	t.Parallel()
+
+	rl := NewRateLimiter()
+	inspector, ok := rl.(RateLimiterInspector)
+	require.True(t, ok)
+	endpoint := AddMemberRole.Compile(nil, 1, 2, 3)
+	assert.Equal(t, time.Duration(0), inspector.EstimateWait(endpoint))
+
+	require.NoError(t, rl.WaitBucket(context.Background(), endpoint))
+	buckets := inspector.Buckets()
+	require.Len(t, buckets, 1)
+	assert.True(t, buckets[0].InFlight)
+	assert.Equal(t, endpoint.BucketKey(), buckets[0].Key)
+
+	require.NoError(t, rl.UnlockBucket(endpoint, rateLimitResponse("10", "0", "10")))
+	buckets = inspector.Buckets()
+	require.Len(t, buckets, 1)
+	assert.Equal(t, "abc", buckets[0].ID)
+	assert.Equal(t, 10, buckets[0].Limit)
+	assert.Equal(t, 0, buckets[0].Remaining)
+	assert.False(t, buckets[0].InFlight)
+	assert.False(t, inspector.Global().Limited)
+
+	// the bucket is exhausted, so we have to wait for the reset
+	wait := inspector.EstimateWait(endpoint)
+	assert.InDelta(t, 10*time.Second, wait, float64(time.Second))
+
+	// other major parameters use a different bucket
+	assert.Equal(t, time.Duration(0), inspector.EstimateWait(AddMemberRole.Compile(nil, 4, 2, 3)))
+
+	_, ok = NewNoopRateLimiter().(RateLimiterInspector)
+	assert.False(t, ok)
+}
+
+func TestPriorityMutex_Order(t *testing.T) {
+	t.Parallel()
+
+	m := newPriorityMutex(0)
+	require.NoError(t, m.Lock(context.Background(), PriorityNormal))
+
+	order := make(chan string, 4)
+	lock := func(name string, priority Priority) {
+		waiters := m.Waiters()
+		go func() {
+			if err := m.Lock(context.Background(), priority); err != nil {
+				return
+			}
+			order <- name
+			m.Unlock()
+		}()
+		// make sure the waiters are queued in order
+		require.Eventually(t, func() bool { return m.Waiters() == waiters+1 }, time.Second, time.Millisecond)
+	}
+	lock("low", PriorityLow)
+	lock("normal1", PriorityNormal)
+	lock("high", PriorityHigh)
+	lock("normal2", PriorityNormal)
+
+	m.Unlock()
+	assert.Equal(t, "high", <-order)
+	assert.Equal(t, "normal1", <-order)
+	assert.Equal(t, "normal2", <-order)
+	assert.Equal(t, "low", <-order)
+}
+
+func TestPriorityMutex_Aging(t *testing.T) {
+	t.Parallel()
+
+	m := newPriorityMutex(10 * time.Millisecond)
+	require.NoError(t, m.Lock(context.Background(), PriorityNormal))
+
+	order := make(chan Priority, 2)
+	lock := func(priority Priority) {
+		waiters := m.Waiters()
+		go func() {
+			if m.Lock(context.Background(), priority) == nil {
+				order <- priority
+				m.Unlock()
+			}
+		}()
+		require.Eventually(t, func() bool { return m.Waiters() == waiters+1 }, time.Second, time.Millisecond)
+	}
+
+	lock(PriorityLow)
+	// the low priority waiter ages past a fresh high priority waiter
+	time.Sleep(50 * time.Millisecond)
+	lock(PriorityHigh)
+
+	m.Unlock()
+	assert.Equal(t, PriorityLow, <-order)
+	assert.Equal(t, PriorityHigh, <-order)
+}
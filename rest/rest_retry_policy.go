@@ -0,0 +1,83 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"time"
+)
+
+// DefaultRetryPolicy returns the RetryPolicy used by default by the rest Client.
+// It retries idempotent requests up to 3 times on network errors & 5xx responses.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   500 * time.Millisecond,
+		MaxDelay:    10 * time.Second,
+		Jitter:      0.2,
+		IdempotentMethods: []string{
+			http.MethodGet,
+			http.MethodHead,
+			http.MethodOptions,
+			http.MethodPut,
+			http.MethodDelete,
+		},
+		RetryStatusCodes: []int{
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// RetryPolicy configures how the rest Client retries requests which failed due to transient errors like network errors or 5xx responses.
+// Rate limited requests are handled by the RateLimiter and are not affected by the RetryPolicy.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts of a request including the first one. A value of 1 or less disables retrying.
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry. It doubles with each further retry.
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between retries.
+	MaxDelay time.Duration
+	// Jitter is the fraction in the range [0, 1] the delay is randomly varied by.
+	Jitter float64
+	// IdempotentMethods are the http methods which are retried by default.
+	// Requests with other methods are only retried when opted in via WithIdempotent.
+	IdempotentMethods []string
+	// RetryStatusCodes are the http status codes which are considered transient.
+	RetryStatusCodes []int
+}
+
+// Idempotent returns whether requests to the given Endpoint are retried by default.
+func (p RetryPolicy) Idempotent(endpoint *Endpoint) bool {
+	return slices.Contains(p.IdempotentMethods, endpoint.Method)
+}
+
+// ShouldRetry returns whether a request should be retried after the given attempt with the given http.Response or error.
+func (p RetryPolicy) ShouldRetry(attempt int, idempotent bool, rs *http.Response, err error) bool {
+	if !idempotent || attempt >= p.MaxAttempts {
+		return false
+	}
+	if err != nil {
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	return rs != nil && slices.Contains(p.RetryStatusCodes, rs.StatusCode)
+}
+
+// Backoff returns the delay before the next attempt after the given attempt.
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < attempt && (p.MaxDelay <= 0 || delay < p.MaxDelay); i++ {
+		delay *= 2
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if p.Jitter > 0 {
+		delay += time.Duration(float64(delay) * p.Jitter * (rand.Float64()*2 - 1))
+	}
+	return delay
+}
@@ -0,0 +1,112 @@
+package rest
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/disgoorg/disgo/discord"
+)
+
+func newRetryTestClient(t *testing.T, failures int32) (Client, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) <= failures {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+
+	policy := DefaultRetryPolicy()
+	policy.BaseDelay = time.Millisecond
+	return NewClient("", WithURL(srv.URL), WithRateLimiter(NewNoopRateLimiter()), WithRetryPolicy(policy)), &calls
+}
+
+func TestClient_RetryIdempotent(t *testing.T) {
+	t.Parallel()
+
+	client, calls := newRetryTestClient(t, 2)
+	err := client.Do(GetChannel.Compile(nil, 1), nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestClient_RetryMaxAttempts(t *testing.T) {
+	t.Parallel()
+
+	client, calls := newRetryTestClient(t, 5)
+	err := client.Do(DeleteChannel.Compile(nil, 1), nil, nil)
+	assert.Error(t, err)
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestClient_RetryNonIdempotent(t *testing.T) {
+	t.Parallel()
+
+	client, calls := newRetryTestClient(t, 1)
+	err := client.Do(CreateMessage.Compile(nil, 1), nil, nil)
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), calls.Load())
+
+	err = client.Do(CreateMessage.Compile(nil, 1), nil, nil, WithIdempotent(true))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	t.Parallel()
+
+	policy := RetryPolicy{BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+	assert.Equal(t, time.Second, policy.Backoff(1))
+	assert.Equal(t, 2*time.Second, policy.Backoff(2))
+	assert.Equal(t, 4*time.Second, policy.Backoff(3))
+	assert.Equal(t, 5*time.Second, policy.Backoff(4))
+}
+
+func TestClient_RetryMultipartBody(t *testing.T) {
+	t.Parallel()
+
+	var (
+		calls  atomic.Int32
+		bodies = make(chan int64, 2)
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n, _ := io.Copy(io.Discard, r.Body)
+		bodies <- n
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+
+	policy := DefaultRetryPolicy()
+	policy.BaseDelay = time.Millisecond
+	client := NewClient("", WithURL(srv.URL), WithRateLimiter(NewNoopRateLimiter()), WithRetryPolicy(policy))
+
+	body, err := discord.MessageCreate{
+		Content: "test",
+		Files:   []*discord.File{discord.NewFile("test.txt", "", strings.NewReader("file content"))},
+	}.ToBody()
+	require.NoError(t, err)
+
+	err = client.Do(CreateMessage.Compile(nil, 1), body, nil, WithIdempotent(true))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), calls.Load())
+
+	length := body.(*discord.MultipartBody).ContentLength()
+	assert.Equal(t, length, <-bodies)
+	assert.Equal(t, length, <-bodies)
+}
@@ -0,0 +1,236 @@
+package rest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/disgoorg/json/v2"
+)
+
+// ErrNoInteraction is returned by a VCR in VCRModeReplay when the Cassette has no interaction left for a request
+var ErrNoInteraction = errors.New("no recorded interaction for request")
+
+// VCRMode is the mode of a VCR
+type VCRMode int
+
+const (
+	// VCRModeReplay serves all responses from the Cassette without sending any requests
+	VCRModeReplay VCRMode = iota
+	// VCRModeRecord sends all requests and records them to the Cassette
+	VCRModeRecord
+)
+
+// vcrRedactedHeaders are request headers which are never written to a Cassette
+var vcrRedactedHeaders = []string{"Authorization", "Cookie"}
+
+// Cassette holds the recorded HTTP interactions of a VCR
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded HTTP request and its response
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a request of an Interaction. The Authorization header is never recorded.
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// key returns the key used to match requests in VCRModeReplay. It includes a hash of the body, so requests with a different body don't match.
+func (r RecordedRequest) key() string {
+	key := r.Method + " " + r.URL
+	if r.Body == "" {
+		return key
+	}
+	body := r.Body
+	// multipart boundaries are random per request
+	if _, params, err := mime.ParseMediaType(r.Header.Get("Content-Type")); err == nil && params["boundary"] != "" {
+		body = strings.ReplaceAll(body, params["boundary"], "")
+	}
+	hash := sha256.Sum256([]byte(body))
+	return key + " " + hex.EncodeToString(hash[:8])
+}
+
+// RecordedResponse is a response of an Interaction including all rate limit headers
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// NewVCR returns a new VCR for the cassette file at the given path.
+// In VCRModeReplay the cassette is loaded immediately, in VCRModeRecord it is written by VCR.Save.
+// Use WithVCR to apply it to a Client.
+func NewVCR(path string, mode VCRMode) (*VCR, error) {
+	vcr := &VCR{
+		path:    path,
+		mode:    mode,
+		pending: map[string][]Interaction{},
+	}
+	if mode == VCRModeRecord {
+		return vcr, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cassette: %w", err)
+	}
+	if err = json.Unmarshal(data, &vcr.cassette); err != nil {
+		return nil, fmt.Errorf("failed to decode cassette: %w", err)
+	}
+	for _, interaction := range vcr.cassette.Interactions {
+		key := interaction.Request.key()
+		vcr.pending[key] = append(vcr.pending[key], interaction)
+	}
+	return vcr, nil
+}
+
+// VCR is a http.RoundTripper which records HTTP interactions to a Cassette or replays them.
+// Interactions are replayed per method, url & body in the order they were recorded,
+// so sequences like a 429 followed by a successful retry are served back deterministically.
+// While recording, all request & response bodies are kept in memory until VCR.Save, so only use it for bounded sessions like tests.
+type VCR struct {
+	path      string
+	mode      VCRMode
+	transport http.RoundTripper
+
+	mu       sync.Mutex
+	cassette Cassette
+	pending  map[string][]Interaction
+}
+
+// Mode returns the VCRMode of the VCR
+func (v *VCR) Mode() VCRMode {
+	return v.mode
+}
+
+// Remaining returns the number of recorded interactions which have not been replayed yet
+func (v *VCR) Remaining() int {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	var n int
+	for _, interactions := range v.pending {
+		n += len(interactions)
+	}
+	return n
+}
+
+// Save writes all recorded interactions to the cassette file. It does nothing in VCRModeReplay.
+func (v *VCR) Save() error {
+	if v.mode != VCRModeRecord {
+		return nil
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	data, err := json.MarshalIndent(v.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(v.path, data, 0o644)
+}
+
+func (v *VCR) RoundTrip(rq *http.Request) (*http.Response, error) {
+	if v.mode == VCRModeRecord {
+		return v.record(rq)
+	}
+	return v.replay(rq)
+}
+
+func (v *VCR) replay(rq *http.Request) (*http.Response, error) {
+	var rqBody []byte
+	if rq.Body != nil {
+		var err error
+		if rqBody, err = io.ReadAll(rq.Body); err != nil {
+			return nil, err
+		}
+		_ = rq.Body.Close()
+	}
+
+	key := RecordedRequest{Method: rq.Method, URL: rq.URL.RequestURI(), Header: rq.Header, Body: string(rqBody)}.key()
+	v.mu.Lock()
+	interactions := v.pending[key]
+	if len(interactions) == 0 {
+		v.mu.Unlock()
+		return nil, fmt.Errorf("%w: %s", ErrNoInteraction, key)
+	}
+	interaction := interactions[0]
+	v.pending[key] = interactions[1:]
+	v.mu.Unlock()
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+		StatusCode:    interaction.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        interaction.Response.Header.Clone(),
+		Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+		ContentLength: int64(len(interaction.Response.Body)),
+		Request:       rq,
+	}, nil
+}
+
+func (v *VCR) record(rq *http.Request) (*http.Response, error) {
+	var rqBody []byte
+	if rq.Body != nil {
+		var err error
+		if rqBody, err = io.ReadAll(rq.Body); err != nil {
+			return nil, err
+		}
+		_ = rq.Body.Close()
+		rq = rq.Clone(rq.Context())
+		rq.Body = io.NopCloser(bytes.NewReader(rqBody))
+	}
+
+	transport := v.transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	rs, err := transport.RoundTrip(rq)
+	if err != nil {
+		return nil, err
+	}
+	rsBody, err := io.ReadAll(rs.Body)
+	_ = rs.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	rs.Body = io.NopCloser(bytes.NewReader(rsBody))
+
+	header := rq.Header.Clone()
+	for _, name := range vcrRedactedHeaders {
+		header.Del(name)
+	}
+	v.mu.Lock()
+	v.cassette.Interactions = append(v.cassette.Interactions, Interaction{
+		Request: RecordedRequest{
+			Method: rq.Method,
+			URL:    rq.URL.RequestURI(),
+			Header: header,
+			Body:   string(rqBody),
+		},
+		Response: RecordedResponse{
+			StatusCode: rs.StatusCode,
+			Header:     rs.Header.Clone(),
+			Body:       string(rsBody),
+		},
+	})
+	v.mu.Unlock()
+	return rs, nil
+}
@@ -0,0 +1,108 @@
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/disgoorg/disgo/discord"
+)
+
+func TestVCR_RecordReplay(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bot token", r.Header.Get("Authorization"))
+		w.Header().Set("Via", "1.1 google")
+		w.Header().Set("X-RateLimit-Bucket", "users")
+		w.Header().Set("X-RateLimit-Limit", "5")
+		w.Header().Set("X-RateLimit-Reset-After", "0")
+		if calls.Add(1) == 1 {
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"message":"You are being rate limited.","retry_after":0,"global":false}`))
+			return
+		}
+		w.Header().Set("X-RateLimit-Remaining", "4")
+		_, _ = w.Write([]byte(`{"id":"1","username":"test"}`))
+	}))
+	defer srv.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	recorder, err := NewVCR(path, VCRModeRecord)
+	require.NoError(t, err)
+	user, err := NewUsers(NewClient("token", WithURL(srv.URL), WithVCR(recorder))).GetUser(1)
+	require.NoError(t, err)
+	assert.Equal(t, "test", user.Username)
+	require.NoError(t, recorder.Save())
+	assert.Equal(t, int32(2), calls.Load())
+
+	player, err := NewVCR(path, VCRModeReplay)
+	require.NoError(t, err)
+	assert.Equal(t, 2, player.Remaining())
+	for _, interaction := range player.cassette.Interactions {
+		assert.Empty(t, interaction.Request.Header.Get("Authorization"))
+	}
+
+	user, err = NewUsers(NewClient("token", WithURL(srv.URL), WithVCR(player))).GetUser(1)
+	require.NoError(t, err)
+	assert.Equal(t, "test", user.Username)
+	assert.Equal(t, 0, player.Remaining())
+	assert.Equal(t, int32(2), calls.Load())
+
+	_, err = NewUsers(NewClient("token", WithURL(srv.URL), WithVCR(player))).GetUser(1)
+	assert.ErrorIs(t, err, ErrNoInteraction)
+}
+
+func TestVCR_ReplayBody(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"1","channel_id":"2"}`))
+	}))
+	defer srv.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	withFile := func(content string) discord.MessageCreate {
+		return discord.MessageCreate{
+			Content: content,
+			Files:   []*discord.File{discord.NewFile("a.txt", "", strings.NewReader("a"))},
+		}
+	}
+
+	recorder, err := NewVCR(path, VCRModeRecord)
+	require.NoError(t, err)
+	channels := NewChannels(NewClient("token", WithURL(srv.URL), WithVCR(recorder)))
+	_, err = channels.CreateMessage(2, discord.MessageCreate{Content: "hello"})
+	require.NoError(t, err)
+	_, err = channels.CreateMessage(2, withFile("hello"))
+	require.NoError(t, err)
+	require.NoError(t, recorder.Save())
+
+	player, err := NewVCR(path, VCRModeReplay)
+	require.NoError(t, err)
+	channels = NewChannels(NewClient("token", WithURL(srv.URL), WithVCR(player)))
+
+	_, err = channels.CreateMessage(2, discord.MessageCreate{Content: "bye"})
+	assert.ErrorIs(t, err, ErrNoInteraction)
+	_, err = channels.CreateMessage(2, withFile("bye"))
+	assert.ErrorIs(t, err, ErrNoInteraction)
+	assert.Equal(t, 2, player.Remaining())
+
+	// multipart bodies match regardless of their random boundary
+	_, err = channels.CreateMessage(2, withFile("hello"))
+	require.NoError(t, err)
+	_, err = channels.CreateMessage(2, discord.MessageCreate{Content: "hello"})
+	require.NoError(t, err)
+	assert.Equal(t, 0, player.Remaining())
+}
@@ -0,0 +1,160 @@
+// Package resttest provides a fake rest.Rest which records all calls and serves canned responses for unit tests.
+package resttest
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/disgoorg/json/v2"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/rest"
+)
+
+var (
+	_ rest.Rest   = (*Rest)(nil)
+	_ rest.Client = (*Rest)(nil)
+)
+
+// NewRest returns a new fake Rest. Calls without a registered HandlerFunc succeed with an empty response.
+func NewRest() *Rest {
+	r := &Rest{
+		handlers:    map[*rest.Endpoint]HandlerFunc{},
+		rateLimiter: rest.NewNoopRateLimiter(),
+		httpClient:  &http.Client{},
+	}
+	r.Rest = rest.New(r)
+	return r
+}
+
+// Call is a recorded call of a Rest method
+type Call struct {
+	// Endpoint is the called endpoint like rest.CreateMessage
+	Endpoint *rest.Endpoint
+	// Params are the url params of the Endpoint like the channel ID
+	Params []any
+	// Query are the query values of the call
+	Query discord.QueryValues
+	// URL is the compiled url of the call
+	URL string
+	// Body is the typed request body like discord.MessageCreate or nil.
+	// For multipart requests it is the payload without the files.
+	Body any
+}
+
+// HandlerFunc returns the response for a Call. The response is encoded as JSON and decoded into the return value of the called method.
+type HandlerFunc func(call Call) (any, error)
+
+// Rest is a fake rest.Rest which sends no requests. Instead, it records every Call with its typed arguments
+// and returns the responses of the HandlerFunc(s) registered per rest.Endpoint.
+type Rest struct {
+	rest.Rest
+
+	rateLimiter rest.RateLimiter
+	httpClient  *http.Client
+
+	mu       sync.Mutex
+	calls    []Call
+	handlers map[*rest.Endpoint]HandlerFunc
+}
+
+// Handle registers the HandlerFunc for all calls of the rest.Endpoint
+func (r *Rest) Handle(endpoint *rest.Endpoint, handler HandlerFunc) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.handlers[endpoint] = handler
+}
+
+// Respond registers a static response for all calls of the rest.Endpoint
+func (r *Rest) Respond(endpoint *rest.Endpoint, response any) {
+	r.Handle(endpoint, func(Call) (any, error) {
+		return response, nil
+	})
+}
+
+// Fail registers a static error for all calls of the rest.Endpoint
+func (r *Rest) Fail(endpoint *rest.Endpoint, err error) {
+	r.Handle(endpoint, func(Call) (any, error) {
+		return nil, err
+	})
+}
+
+// Calls returns all recorded calls in the order they were made
+func (r *Rest) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	calls := make([]Call, len(r.calls))
+	copy(calls, r.calls)
+	return calls
+}
+
+// CallsTo returns all recorded calls of the rest.Endpoint in the order they were made
+func (r *Rest) CallsTo(endpoint *rest.Endpoint) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var calls []Call
+	for _, call := range r.calls {
+		if call.Endpoint == endpoint {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// Reset removes all recorded calls and registered HandlerFunc(s)
+func (r *Rest) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = nil
+	clear(r.handlers)
+}
+
+// HTTPClient returns an unused http.Client
+func (r *Rest) HTTPClient() *http.Client {
+	return r.httpClient
+}
+
+// RateLimiter returns a noop rest.RateLimiter
+func (r *Rest) RateLimiter() rest.RateLimiter {
+	return r.rateLimiter
+}
+
+// Close does nothing
+func (r *Rest) Close(_ context.Context) {}
+
+// Do records the Call and decodes the response of the registered HandlerFunc into rsBody
+func (r *Rest) Do(endpoint *rest.CompiledEndpoint, rqBody any, rsBody any, _ ...rest.RequestOpt) error {
+	if multipart, ok := rqBody.(*discord.MultipartBody); ok {
+		rqBody = multipart.Payload
+		_ = multipart.Close()
+	}
+	call := Call{
+		Endpoint: endpoint.Endpoint,
+		Params:   endpoint.Params,
+		Query:    endpoint.Query,
+		URL:      endpoint.URL,
+		Body:     rqBody,
+	}
+
+	r.mu.Lock()
+	r.calls = append(r.calls, call)
+	handler := r.handlers[endpoint.Endpoint]
+	r.mu.Unlock()
+
+	if handler == nil {
+		return nil
+	}
+	response, err := handler(call)
+	if err != nil {
+		return err
+	}
+	if rsBody == nil || response == nil {
+		return nil
+	}
+	data, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, rsBody)
+}
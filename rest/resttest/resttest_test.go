@@ -0,0 +1,40 @@
+package resttest
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/rest"
+)
+
+func TestRest(t *testing.T) {
+	t.Parallel()
+
+	r := NewRest()
+	r.Handle(rest.CreateMessage, func(call Call) (any, error) {
+		create := call.Body.(discord.MessageCreate)
+		return discord.Message{ID: 2, ChannelID: call.Params[0].(snowflake.ID), Content: create.Content}, nil
+	})
+	r.Fail(rest.DeleteMessage, errors.New("unknown message"))
+
+	message, err := r.CreateMessage(1, discord.MessageCreate{Content: "hello"})
+	require.NoError(t, err)
+	assert.Equal(t, discord.Message{ID: 2, ChannelID: 1, Content: "hello"}, *message)
+
+	_, err = r.CreateMessage(1, discord.NewMessageCreateBuilder().SetContent("file").AddFile("a.txt", "", strings.NewReader("content")).Build())
+	require.NoError(t, err)
+
+	assert.EqualError(t, r.DeleteMessage(1, 2), "unknown message")
+
+	calls := r.CallsTo(rest.CreateMessage)
+	require.Len(t, calls, 2)
+	assert.Equal(t, "file", calls[1].Body.(discord.MessageCreate).Content)
+	assert.Equal(t, []any{snowflake.ID(1), snowflake.ID(2)}, r.CallsTo(rest.DeleteMessage)[0].Params)
+	assert.Len(t, r.Calls(), 3)
+}
@@ -1,6 +1,9 @@
 package rest
 
 import (
+	"context"
+	"iter"
+
 	"github.com/disgoorg/snowflake/v2"
 
 	"github.com/disgoorg/disgo/discord"
@@ -17,6 +20,8 @@ type SKUs interface {
 
 	GetSKUSubscriptions(skuID snowflake.ID, before snowflake.ID, after snowflake.ID, limit int, userID snowflake.ID, opts ...RequestOpt) ([]discord.Subscription, error)
 	GetSKUSubscriptionsPage(skuID snowflake.ID, userID snowflake.ID, startID snowflake.ID, limit int, opts ...RequestOpt) Page[discord.Subscription]
+	// GetSKUSubscriptionsIter iterates over all subscriptions of the SKU after the given after ID or before the given before ID if set.
+	GetSKUSubscriptionsIter(ctx context.Context, skuID snowflake.ID, userID snowflake.ID, before snowflake.ID, after snowflake.ID, limit int, opts ...RequestOpt) iter.Seq2[discord.Subscription, error]
 	GetSKUSubscription(skuID snowflake.ID, subscriptionID snowflake.ID, opts ...RequestOpt) (*discord.Subscription, error)
 }
 
@@ -59,6 +64,21 @@ func (s *skusImpl) GetSKUSubscriptionsPage(skuID snowflake.ID, userID snowflake.
 	}
 }
 
+func (s *skusImpl) GetSKUSubscriptionsIter(ctx context.Context, skuID snowflake.ID, userID snowflake.ID, before snowflake.ID, after snowflake.ID, limit int, opts ...RequestOpt) iter.Seq2[discord.Subscription, error] {
+	startID := after
+	if before != 0 {
+		startID = before
+	}
+	return paginateByID(ctx, startID, before == 0, limit,
+		func(subscription discord.Subscription) snowflake.ID {
+			return subscription.ID
+		},
+		func(ctx context.Context, before snowflake.ID, after snowflake.ID) ([]discord.Subscription, error) {
+			return s.GetSKUSubscriptions(skuID, before, after, limit, userID, withCtx(ctx, opts)...)
+		},
+	)
+}
+
 func (s *skusImpl) GetSKUSubscription(skuID snowflake.ID, subscriptionID snowflake.ID, opts ...RequestOpt) (subscription *discord.Subscription, err error) {
 	err = s.client.Do(GetSKUSubscription.Compile(nil, skuID, subscriptionID), nil, &subscription, opts...)
 	return
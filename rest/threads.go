@@ -1,6 +1,8 @@
 package rest
 
 import (
+	"context"
+	"iter"
 	"time"
 
 	"github.com/disgoorg/snowflake/v2"
@@ -26,10 +28,17 @@ type Threads interface {
 	GetThreadMember(threadID snowflake.ID, userID snowflake.ID, withMember bool, opts ...RequestOpt) (threadMember *discord.ThreadMember, err error)
 	GetThreadMembers(threadID snowflake.ID, opts ...RequestOpt) (threadMembers []discord.ThreadMember, err error)
 	GetThreadMembersPage(threadID snowflake.ID, startID snowflake.ID, limit int, opts ...RequestOpt) ThreadMemberPage
+	GetThreadMembersIter(ctx context.Context, threadID snowflake.ID, after snowflake.ID, limit int, opts ...RequestOpt) iter.Seq2[discord.ThreadMember, error]
 
 	GetPublicArchivedThreads(channelID snowflake.ID, before time.Time, limit int, opts ...RequestOpt) (threads *discord.GetThreads, err error)
 	GetPrivateArchivedThreads(channelID snowflake.ID, before time.Time, limit int, opts ...RequestOpt) (threads *discord.GetThreads, err error)
 	GetJoinedPrivateArchivedThreads(channelID snowflake.ID, before time.Time, limit int, opts ...RequestOpt) (threads *discord.GetThreads, err error)
+	// GetPublicArchivedThreadsIter iterates over all public archived threads archived before the given time. If before is zero it starts at the latest archived thread.
+	GetPublicArchivedThreadsIter(ctx context.Context, channelID snowflake.ID, before time.Time, limit int, opts ...RequestOpt) iter.Seq2[discord.GuildThread, error]
+	// GetPrivateArchivedThreadsIter iterates over all private archived threads archived before the given time. If before is zero it starts at the latest archived thread.
+	GetPrivateArchivedThreadsIter(ctx context.Context, channelID snowflake.ID, before time.Time, limit int, opts ...RequestOpt) iter.Seq2[discord.GuildThread, error]
+	// GetJoinedPrivateArchivedThreadsIter iterates over all joined private archived threads with an ID lower than before. If before is 0 it starts at the latest thread.
+	GetJoinedPrivateArchivedThreadsIter(ctx context.Context, channelID snowflake.ID, before snowflake.ID, limit int, opts ...RequestOpt) iter.Seq2[discord.GuildThread, error]
 	GetActiveGuildThreads(guildID snowflake.ID, opts ...RequestOpt) (*discord.GuildActiveThreads, error)
 }
 
@@ -98,6 +107,24 @@ func (s *threadImpl) GetThreadMembersPage(threadID snowflake.ID, startID snowfla
 	}
 }
 
+func (s *threadImpl) GetThreadMembersIter(ctx context.Context, threadID snowflake.ID, after snowflake.ID, limit int, opts ...RequestOpt) iter.Seq2[discord.ThreadMember, error] {
+	return paginateByID(ctx, after, true, limit,
+		func(threadMember discord.ThreadMember) snowflake.ID {
+			return threadMember.UserID
+		},
+		func(ctx context.Context, _ snowflake.ID, after snowflake.ID) ([]discord.ThreadMember, error) {
+			queryValues := discord.QueryValues{
+				"with_member": true,
+				"after":       after,
+			}
+			if limit != 0 {
+				queryValues["limit"] = limit
+			}
+			return s.getThreadMembers(threadID, queryValues, withCtx(ctx, opts)...)
+		},
+	)
+}
+
 func (s *threadImpl) GetPublicArchivedThreads(channelID snowflake.ID, before time.Time, limit int, opts ...RequestOpt) (threads *discord.GetThreads, err error) {
 	queryValues := discord.QueryValues{}
 	if !before.IsZero() {
@@ -134,6 +161,41 @@ func (s *threadImpl) GetJoinedPrivateArchivedThreads(channelID snowflake.ID, bef
 	return
 }
 
+func (s *threadImpl) GetPublicArchivedThreadsIter(ctx context.Context, channelID snowflake.ID, before time.Time, limit int, opts ...RequestOpt) iter.Seq2[discord.GuildThread, error] {
+	return archivedThreadsIter(ctx, before, func(before time.Time, opts ...RequestOpt) (*discord.GetThreads, error) {
+		return s.GetPublicArchivedThreads(channelID, before, limit, opts...)
+	}, opts)
+}
+
+func (s *threadImpl) GetPrivateArchivedThreadsIter(ctx context.Context, channelID snowflake.ID, before time.Time, limit int, opts ...RequestOpt) iter.Seq2[discord.GuildThread, error] {
+	return archivedThreadsIter(ctx, before, func(before time.Time, opts ...RequestOpt) (*discord.GetThreads, error) {
+		return s.GetPrivateArchivedThreads(channelID, before, limit, opts...)
+	}, opts)
+}
+
+func (s *threadImpl) GetJoinedPrivateArchivedThreadsIter(ctx context.Context, channelID snowflake.ID, before snowflake.ID, limit int, opts ...RequestOpt) iter.Seq2[discord.GuildThread, error] {
+	// joined private archived threads are sorted by ID & paged with a thread ID as before instead of an archive timestamp
+	return paginateByID(ctx, before, false, limit,
+		func(thread discord.GuildThread) snowflake.ID {
+			return thread.ID()
+		},
+		func(ctx context.Context, before snowflake.ID, _ snowflake.ID) ([]discord.GuildThread, error) {
+			queryValues := discord.QueryValues{}
+			if before != 0 {
+				queryValues["before"] = before
+			}
+			if limit != 0 {
+				queryValues["limit"] = limit
+			}
+			var threads *discord.GetThreads
+			if err := s.client.Do(GetJoinedPrivateArchivedThreads.Compile(queryValues, channelID), nil, &threads, withCtx(ctx, opts)...); err != nil {
+				return nil, err
+			}
+			return threads.Threads, nil
+		},
+	)
+}
+
 func (s *threadImpl) GetActiveGuildThreads(guildID snowflake.ID, opts ...RequestOpt) (activeThreads *discord.GuildActiveThreads, err error) {
 	err = s.client.Do(GetActiveGuildThreads.Compile(nil, guildID), nil, &activeThreads, opts...)
 	return
@@ -143,3 +205,18 @@ func (s *threadImpl) getThreadMembers(threadID snowflake.ID, queryValues discord
 	err = s.client.Do(GetThreadMembers.Compile(queryValues, threadID), nil, &threadMembers, opts...)
 	return
 }
+
+func archivedThreadsIter(ctx context.Context, before time.Time, getThreads func(before time.Time, opts ...RequestOpt) (*discord.GetThreads, error), opts []RequestOpt) iter.Seq2[discord.GuildThread, error] {
+	return paginateByTime(ctx, before,
+		func(thread discord.GuildThread) time.Time {
+			return thread.ThreadMetadata.ArchiveTimestamp
+		},
+		func(ctx context.Context, before time.Time) ([]discord.GuildThread, bool, error) {
+			threads, err := getThreads(before, withCtx(ctx, opts)...)
+			if err != nil {
+				return nil, false, err
+			}
+			return threads.Threads, threads.HasMore, nil
+		},
+	)
+}